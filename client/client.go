@@ -2,6 +2,8 @@ package client
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"errors"
@@ -37,19 +39,51 @@ var defaultAuth = [2]string{"admin", "changeme"}
 
 // A Client is used to communicate with Splunkd endpoints
 type Client struct {
-	authToken  string
-	sessionKey string
-	auth       [2]string
-	host       string
-	path       string
-	httpClient *http.Client
-	userAgent  string
-	urlEncoded bool
+	authToken          string
+	sessionKey         string
+	auth               [2]string
+	host               string
+	path               string
+	httpClient         *http.Client
+	userAgent          string
+	urlEncoded         bool
+	maxRetries         int
+	retryWaitMin       time.Duration
+	retryWaitMax       time.Duration
+	disableCompression bool
+	requestTimeout     time.Duration
+}
+
+// SetCompression toggles gzip response compression. Compression is on by
+// default; disabling it helps in environments where proxies mangle encoded
+// responses.
+func (c *Client) SetCompression(disable bool) {
+	c.disableCompression = disable
+}
+
+// SetRetryPolicy configures retry-with-backoff for transient HTTP 429 and 503
+// responses from splunkd. maxRetries of zero disables retrying.
+func (c *Client) SetRetryPolicy(maxRetries int, waitMin, waitMax time.Duration) {
+	c.maxRetries = maxRetries
+	c.retryWaitMin = waitMin
+	c.retryWaitMax = waitMax
+}
+
+// SetRequestTimeout bounds every individual request with a deadline. A zero
+// timeout disables the deadline, leaving only the overall http.Client timeout.
+func (c *Client) SetRequestTimeout(timeout time.Duration) {
+	c.requestTimeout = timeout
 }
 
 // NewRequest creates a new HTTP Request and set proper header
 func (c *Client) NewRequest(httpMethod, url string, body io.Reader) (*http.Request, error) {
-	request, err := http.NewRequest(httpMethod, url, body)
+	return c.NewRequestWithContext(context.Background(), httpMethod, url, body)
+}
+
+// NewRequestWithContext is NewRequest bound to ctx, so cancellation and
+// deadlines propagate to the transport.
+func (c *Client) NewRequestWithContext(ctx context.Context, httpMethod, url string, body io.Reader) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, httpMethod, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +95,13 @@ func (c *Client) NewRequest(httpMethod, url string, body io.Reader) (*http.Reque
 		request.SetBasicAuth(c.auth[0], c.auth[1])
 	}
 	request.Header.Set("Accept", "application/json")
+	// identity opts out explicitly, since the transport would otherwise
+	// advertise gzip on its own.
+	if c.disableCompression {
+		request.Header.Set("Accept-Encoding", "identity")
+	} else {
+		request.Header.Set("Accept-Encoding", "gzip")
+	}
 	request.Header.Set("User-Agent", c.userAgent)
 	if c.urlEncoded {
 		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -89,7 +130,9 @@ func (c *Client) BuildSplunkURL(queryValues url.Values, urlPathParts ...string)
 	}
 
 	queryValues.Set("output_mode", "json")
-	queryValues.Set("count", "-1") // To avoid http response truncation
+	if queryValues.Get("count") == "" {
+		queryValues.Set("count", "-1") // To avoid http response truncation
+	}
 	httpScheme := getEnv(envVarHTTPScheme, defaultScheme)
 
 	return url.URL{
@@ -136,38 +179,178 @@ func (c *Client) Patch(patchURL url.URL, body interface{}) (*http.Response, erro
 
 // DoRequest creates and execute a new request
 func (c *Client) DoRequest(method string, requestURL url.URL, body interface{}) (*http.Response, error) {
-	var buffer *bytes.Buffer
+	var content []byte
 	if contentBytes, ok := body.([]byte); ok {
-		buffer = bytes.NewBuffer(contentBytes)
+		content = contentBytes
 	} else {
-		if content, err := c.EncodeRequestBody(body); err == nil {
-			buffer = bytes.NewBuffer(content)
-		} else {
+		encoded, err := c.EncodeRequestBody(body)
+		if err != nil {
 			return nil, err
 		}
+		content = encoded
 	}
-	request, err := c.NewRequest(method, requestURL.String(), buffer)
-	if err != nil {
-		return nil, err
+
+	var response *http.Response
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := c.requestContext()
+		request, err := c.NewRequestWithContext(ctx, method, requestURL.String(), bytes.NewBuffer(content))
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		response, err = c.Do(request)
+		if err != nil {
+			cancel()
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, fmt.Errorf("request to %s timed out after %s", requestURL.Path, c.requestTimeout)
+			}
+			return nil, err
+		}
+		if response == nil {
+			cancel()
+			return nil, fmt.Errorf("nil response for '%s' request", &requestURL)
+		}
+		if attempt < c.maxRetries && retryableStatusCode(response.StatusCode) {
+			wait := c.retryWait(attempt, response.Header.Get("Retry-After"))
+			response.Body.Close()
+			cancel()
+			time.Sleep(wait)
+			continue
+		}
+		// The deadline has to stay live until the caller has drained the
+		// body, so releasing the context is deferred to Body.Close.
+		response.Body = &cancelReadCloser{ReadCloser: response.Body, cancel: cancel}
+		break
 	}
-	response, err := c.Do(request)
+
+	response, err := decompressResponse(response)
 	if err != nil {
 		return nil, err
 	}
-	if response == nil {
-		return nil, fmt.Errorf("nil response for '%s' request", &requestURL)
-	}
+
 	if response.StatusCode != 200 && response.StatusCode != 201 {
 		body, err := io.ReadAll(response.Body)
 		if err != nil {
 			return nil, err
 		}
-		return response, fmt.Errorf("%s: %s", response.Status, string(body))
+		return response, parseSplunkError(response, body)
+	}
+
+	return response, nil
+}
+
+// parseSplunkError builds the error for a non-2xx response from the HTTP
+// status, every text in the standard Splunk messages envelope, and the
+// request URL. Bodies that are not the envelope are included verbatim so no
+// detail is lost.
+func parseSplunkError(response *http.Response, body []byte) error {
+	envelope := struct {
+		Messages []struct {
+			Text string `json:"text"`
+		} `json:"messages"`
+	}{}
+	texts := []string{}
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		for _, message := range envelope.Messages {
+			if message.Text != "" {
+				texts = append(texts, message.Text)
+			}
+		}
+	}
+	detail := strings.TrimSpace(string(body))
+	if len(texts) > 0 {
+		detail = strings.Join(texts, "; ")
 	}
+	if response.Request != nil && response.Request.URL != nil {
+		return fmt.Errorf("%s from %s: %s", response.Status, response.Request.URL.Redacted(), detail)
+	}
+	return fmt.Errorf("%s: %s", response.Status, detail)
+}
+
+// requestContext returns the context a single request attempt runs under:
+// bounded by the configured per-request timeout when one is set, unbounded
+// otherwise.
+func (c *Client) requestContext() (context.Context, context.CancelFunc) {
+	if c.requestTimeout > 0 {
+		return context.WithTimeout(context.Background(), c.requestTimeout)
+	}
+	return context.Background(), func() {}
+}
+
+// cancelReadCloser releases a request's context once the caller is done with
+// the response body; cancelling any earlier would abort in-flight body reads.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *cancelReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
 
+// decompressResponse transparently unwraps a gzip-encoded response body so
+// callers can decode JSON without caring about transport compression.
+func decompressResponse(response *http.Response) (*http.Response, error) {
+	if !strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		return response, nil
+	}
+	reader, err := gzip.NewReader(response.Body)
+	if err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+	response.Body = &gzipReadCloser{reader: reader, underlying: response.Body}
+	response.Header.Del("Content-Encoding")
+	response.ContentLength = -1
 	return response, nil
 }
 
+// gzipReadCloser reads through the gzip reader while closing both it and the
+// underlying connection body.
+type gzipReadCloser struct {
+	reader     *gzip.Reader
+	underlying io.ReadCloser
+}
+
+func (g *gzipReadCloser) Read(p []byte) (int, error) {
+	return g.reader.Read(p)
+}
+
+func (g *gzipReadCloser) Close() error {
+	if err := g.reader.Close(); err != nil {
+		g.underlying.Close()
+		return err
+	}
+	return g.underlying.Close()
+}
+
+// retryableStatusCode reports whether a status code is safe to retry: splunkd
+// rejects the request before processing it on 429 and 503, so a repeat cannot
+// duplicate a write.
+func retryableStatusCode(code int) bool {
+	return code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable
+}
+
+// retryWait computes the exponential backoff for an attempt, bounded by the
+// configured minimum and maximum and honoring a Retry-After header if present.
+func (c *Client) retryWait(attempt int, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	wait := c.retryWaitMin << uint(attempt)
+	if wait > c.retryWaitMax {
+		wait = c.retryWaitMax
+	}
+	if wait < c.retryWaitMin {
+		wait = c.retryWaitMin
+	}
+	return wait
+}
+
 func (c *Client) Login() (e error) {
 	loginURL := c.BuildSplunkURL(nil, "services", "auth", "login")
 	bodyValues := map[string]string{
@@ -199,6 +382,21 @@ func (c *Client) Login() (e error) {
 	return err
 }
 
+// VerifyConnectivity issues a single authenticated request against the server
+// info endpoint so misconfigured credentials or an unreachable host fail fast
+// at provider configure time instead of on the first resource operation.
+func (c *Client) VerifyConnectivity() error {
+	endpoint := c.BuildSplunkURL(nil, "services", "server", "info")
+	resp, err := c.Get(endpoint)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("unable to connect to Splunk instance %s: %w", c.host, err)
+	}
+	return nil
+}
+
 // EncodeRequestBody takes a json string or object and serializes it to be used in request body
 func (c *Client) EncodeRequestBody(content interface{}) ([]byte, error) {
 	if content != nil {
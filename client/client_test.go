@@ -2,6 +2,8 @@ package client
 
 import (
 	"bytes"
+	"compress/gzip"
+	"encoding/json"
 	"io"
 	"log"
 	"math"
@@ -10,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -442,3 +445,247 @@ func TestSendsCookies(t *testing.T) {
 		t.Errorf("Returned Cookie is %v, want %v", got, want)
 	}
 }
+
+func TestVerifyConnectivityAuthFailure(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"messages":[{"type":"ERROR","text":"Unauthorized"}]}`, http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.VerifyConnectivity()
+	if err == nil {
+		t.Fatal("expected an error for an auth failure, got nil")
+	}
+	if !strings.Contains(err.Error(), u.Host) {
+		t.Errorf("expected the error to name the host %q, got: %v", u.Host, err)
+	}
+}
+
+func TestVerifyConnectivity(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"entry":[{"name":"server-info"}]}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.VerifyConnectivity(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
+
+func TestParseSplunkErrorMultiMessage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"messages":[{"type":"ERROR","text":"Unknown field: bogus"},{"type":"WARN","text":"Search disabled"}]}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Get(client.BuildSplunkURL(nil, "services", "saved", "searches"))
+	if err == nil {
+		t.Fatal("expected an error for a 400 response")
+	}
+	defer resp.Body.Close()
+
+	for _, want := range []string{"400 Bad Request", "Unknown field: bogus", "Search disabled", "/services/saved/searches"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to contain %q, got: %v", want, err)
+		}
+	}
+}
+
+func TestParseSplunkErrorNonEnvelopeBody(t *testing.T) {
+	resp := &http.Response{Status: "500 Internal Server Error"}
+	err := parseSplunkError(resp, []byte("splunkd is restarting\n"))
+	if !strings.Contains(err.Error(), "500 Internal Server Error") || !strings.Contains(err.Error(), "splunkd is restarting") {
+		t.Errorf("expected the raw body to be preserved, got: %v", err)
+	}
+}
+
+func TestDoRequestHonorsRequestTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"entry":[]}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRequestTimeout(20 * time.Millisecond)
+
+	endpoint := client.BuildSplunkURL(nil, "services", "server", "info")
+	_, err = client.Get(endpoint)
+	if err == nil {
+		t.Fatal("expected a timeout error, got nil")
+	}
+	// The error names the endpoint and the configured timeout so the user
+	// knows what to raise.
+	if !strings.Contains(err.Error(), "services/server/info") {
+		t.Errorf("error does not mention the endpoint: %s", err)
+	}
+	if !strings.Contains(err.Error(), "20ms") {
+		t.Errorf("error does not mention the configured timeout: %s", err)
+	}
+}
+
+func TestDoRequestRetriesTransientErrors(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"entry":[]}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRetryPolicy(3, time.Millisecond, 10*time.Millisecond)
+
+	endpoint := client.BuildSplunkURL(nil, "services", "server", "info")
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	client := &Client{retryWaitMin: time.Second, retryWaitMax: 4 * time.Second}
+
+	if got := client.retryWait(0, ""); got != time.Second {
+		t.Errorf("first attempt wait invalid, got %s", got)
+	}
+	if got := client.retryWait(1, ""); got != 2*time.Second {
+		t.Errorf("second attempt wait invalid, got %s", got)
+	}
+	// Backoff is capped at the configured maximum
+	if got := client.retryWait(5, ""); got != 4*time.Second {
+		t.Errorf("capped wait invalid, got %s", got)
+	}
+	// Retry-After takes precedence over the computed backoff
+	if got := client.retryWait(0, "3"); got != 3*time.Second {
+		t.Errorf("Retry-After wait invalid, got %s", got)
+	}
+}
+
+func TestGzipResponseDecompression(t *testing.T) {
+	var acceptEncoding string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		payload := []byte(`{"entry": [{"name": "My Report"}]}`)
+		if strings.Contains(acceptEncoding, "gzip") {
+			w.Header().Set("Content-Encoding", "gzip")
+			gz := gzip.NewWriter(w)
+			defer gz.Close()
+			_, _ = gz.Write(payload)
+			return
+		}
+		_, _ = w.Write(payload)
+	}))
+	defer ts.Close()
+
+	os.Setenv(envVarHTTPScheme, "http")
+	defer os.Unsetenv(envVarHTTPScheme)
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Get(client.BuildSplunkURL(nil, "services", "saved", "searches"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if !strings.Contains(acceptEncoding, "gzip") {
+		t.Errorf("expected the request to advertise gzip, got Accept-Encoding %q", acceptEncoding)
+	}
+	// JSON decoding must work through the transparent gzip reader
+	decoded := struct {
+		Entry []struct {
+			Name string `json:"name"`
+		} `json:"entry"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		t.Fatalf("unexpected decode error through gzip reader: %v", err)
+	}
+	if len(decoded.Entry) != 1 || decoded.Entry[0].Name != "My Report" {
+		t.Errorf("unexpected decoded response: %+v", decoded)
+	}
+
+	// With compression disabled the request must not advertise gzip
+	client.SetCompression(true)
+	resp, err = client.Get(client.BuildSplunkURL(nil, "services", "saved", "searches"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if strings.Contains(acceptEncoding, "gzip") {
+		t.Errorf("expected no gzip advertisement when compression is disabled, got %q", acceptEncoding)
+	}
+}
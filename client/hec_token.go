@@ -0,0 +1,73 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/splunk/terraform-provider-splunk/client/models"
+
+	"github.com/google/go-querystring/query"
+)
+
+func (client *Client) CreateHECTokenObject(name string, owner string, app string, hecTokenObj *models.HECTokenObject) error {
+	values, err := query.Values(hecTokenObj)
+	if err != nil {
+		return err
+	}
+	values.Add("name", name)
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "data", "inputs", "http", name)
+	resp, err := client.Post(endpoint, values)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (client *Client) ReadHECTokenObject(name, owner, app string) (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "data", "inputs", "http", name)
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (client *Client) UpdateHECTokenObject(name string, owner string, app string, hecTokenObj *models.HECTokenObject) error {
+	values, err := query.Values(&hecTokenObj)
+	if err != nil {
+		return err
+	}
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "data", "inputs", "http", name)
+	resp, err := client.Post(endpoint, values)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// RotateHECTokenObject asks splunkd to regenerate the token value for an
+// existing HTTP Event Collector input.
+func (client *Client) RotateHECTokenObject(name, owner, app string) error {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "data", "inputs", "http", name, "rotate")
+	resp, err := client.Post(endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (client *Client) DeleteHECTokenObject(name, owner, app string) (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "data", "inputs", "http", name)
+	resp, err := client.Delete(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
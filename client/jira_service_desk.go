@@ -0,0 +1,37 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// GetJiraServiceDeskIssueTypes queries the JIRA Service Desk add-on's cached
+// project metadata for the issue types valid for the given project. This
+// requires the add-on's REST handler to be installed on the Splunk instance.
+func (client *Client) GetJiraServiceDeskIssueTypes(account, project string) ([]string, error) {
+	queryValues := url.Values{}
+	queryValues.Set("account", account)
+	queryValues.Set("jira_project", project)
+
+	endpoint := client.BuildSplunkURL(queryValues, "services", "jira_service_desk", "jira_issue_types")
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	decoded := struct {
+		Entry []struct {
+			Name string `json:"name"`
+		} `json:"entry"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	issueTypes := make([]string, 0, len(decoded.Entry))
+	for _, entry := range decoded.Entry {
+		issueTypes = append(issueTypes, entry.Name)
+	}
+	return issueTypes, nil
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestGetJiraServiceDeskIssueTypes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("jira_project"), "OPS"; got != want {
+			t.Errorf("jira_project invalid, got %s, want %s", got, want)
+		}
+		_, _ = w.Write([]byte(`{"entry":[{"name":"Task"},{"name":"Bug"}]}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issueTypes, err := client.GetJiraServiceDeskIssueTypes("test_account", "OPS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Task", "Bug"}; !reflect.DeepEqual(issueTypes, want) {
+		t.Errorf("issue types invalid, got %v, want %v", issueTypes, want)
+	}
+}
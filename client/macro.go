@@ -0,0 +1,82 @@
+package client
+
+import (
+	"log"
+	"net/http"
+	"net/http/httputil"
+
+	"github.com/google/go-querystring/query"
+	"github.com/splunk/terraform-provider-splunk/client/models"
+)
+
+func (client *Client) CreateMacroObject(owner string, app string, macroObj *models.MacroObject) error {
+	values, err := query.Values(&macroObj)
+	if err != nil {
+		return err
+	}
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "admin", "macros")
+	resp, err := client.Post(endpoint, values)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, error := httputil.DumpResponse(resp, true)
+	if error != nil {
+		log.Printf("[ERROR] Error occured during CreateMacroObject %s", error)
+	}
+
+	log.Printf("[DEBUG] Response object returned from CreateMacroObject is: %s", string(respBody))
+	return nil
+}
+
+func (client *Client) ReadMacroObject(name, owner, app string) (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "admin", "macros", name)
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (client *Client) UpdateMacroObject(owner string, app string, name string, macroObj *models.MacroObject) error {
+	values, err := query.Values(&macroObj)
+	if err != nil {
+		return err
+	}
+	values.Del("name")
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "admin", "macros", name)
+	resp, err := client.Post(endpoint, values)
+	if err != nil {
+		return err
+	}
+	respBody, error := httputil.DumpResponse(resp, true)
+	if error != nil {
+		log.Printf("[ERROR] Error occured during UpdateMacroObject %s", error)
+	}
+
+	log.Printf("[DEBUG] Response object returned from UpdateMacroObject is: %s", string(respBody))
+
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (client *Client) DeleteMacroObject(owner string, app string, name string) (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "admin", "macros", name)
+	resp, err := client.Delete(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	respBody, error := httputil.DumpResponse(resp, true)
+	if error != nil {
+		log.Printf("[ERROR] Error occured during DeleteMacroObject %s", error)
+	}
+
+	log.Printf("[DEBUG] Response object returned from DeleteMacroObject is: %s", string(respBody))
+
+	defer resp.Body.Close()
+
+	return resp, nil
+}
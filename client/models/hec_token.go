@@ -0,0 +1,22 @@
+package models
+
+// HEC Token Response Schema
+type HECTokenResponse struct {
+	Entry    []HECTokenEntry `json:"entry"`
+	Messages []ErrorMessage  `json:"messages"`
+}
+
+type HECTokenEntry struct {
+	Name    string         `json:"name"`
+	ACL     ACLObject      `json:"acl"`
+	Content HECTokenObject `json:"content"`
+}
+
+type HECTokenObject struct {
+	Indexes    []interface{} `json:"indexes,omitempty" url:"indexes,omitempty"`
+	Index      string        `json:"index,omitempty" url:"index,omitempty"`
+	Source     string        `json:"source,omitempty" url:"source,omitempty"`
+	SourceType string        `json:"sourcetype,omitempty" url:"sourcetype,omitempty"`
+	Token      string        `json:"token,omitempty" url:"token,omitempty"`
+	Disabled   bool          `json:"disabled,omitempty" url:"disabled"`
+}
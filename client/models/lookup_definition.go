@@ -12,6 +12,11 @@ type SplunkLookupDefinitionEntry struct {
 }
 
 type SplunkLookupDefinitionObject struct {
-	Name     string `json:"name,omitempty" url:"name,omitempty"`
-	Filename string `json:"filename,omitempty" url:"filename,omitempty"`
+	Name         string `json:"name,omitempty" url:"name,omitempty"`
+	Filename     string `json:"filename,omitempty" url:"filename,omitempty"`
+	Collection   string `json:"collection,omitempty" url:"collection,omitempty"`
+	ExternalCmd  string `json:"external_cmd,omitempty" url:"external_cmd,omitempty"`
+	ExternalType string `json:"external_type,omitempty" url:"external_type,omitempty"`
+	FieldsList   string `json:"fields_list,omitempty" url:"fields_list,omitempty"`
+	MaxMatches   int    `json:"max_matches,omitempty" url:"max_matches,omitempty"`
 }
@@ -0,0 +1,21 @@
+package models
+
+type MacroResponse struct {
+	Entry    []MacroEntry   `json:"entry"`
+	Messages []ErrorMessage `json:"messages"`
+}
+
+type MacroEntry struct {
+	Name    string      `json:"name"`
+	ACL     ACLObject   `json:"acl"`
+	Content MacroObject `json:"content"`
+}
+
+type MacroObject struct {
+	Name       string `json:"name,omitempty" url:"name,omitempty"`
+	Definition string `json:"definition,omitempty" url:"definition,omitempty"`
+	Arguments  string `json:"args,omitempty" url:"args,omitempty"`
+	Validation string `json:"validation,omitempty" url:"validation,omitempty"`
+	Errormsg   string `json:"errormsg,omitempty" url:"errormsg,omitempty"`
+	Iseval     string `json:"iseval,omitempty" url:"iseval,omitempty"`
+}
@@ -2,18 +2,31 @@ package models
 
 type SavedSearchesResponse struct {
 	Entry    []SavedSearchesEntry `json:"entry"`
+	Paging   Paging               `json:"paging"`
 	Messages []ErrorMessage       `json:"messages"`
 }
 
+type Paging struct {
+	Total   int `json:"total"`
+	PerPage int `json:"perPage"`
+	Offset  int `json:"offset"`
+}
+
 type SavedSearchesEntry struct {
 	Name    string            `json:"name"`
+	Updated string            `json:"updated"`
 	ACL     ACLObject         `json:"acl"`
 	Content SavedSearchObject `json:"content"`
+
+	// WebhookHeaders collects the dynamic action.webhook.param.http_header.*
+	// keys, which cannot be modeled as fixed struct fields.
+	WebhookHeaders map[string]string `json:"-"`
 }
 
 type SavedSearchObject struct {
 	Actions                                      string  `json:"actions,omitempty" url:"actions,omitempty"`
 	ActionEmail                                  bool    `json:"action.email,omitempty" url:"action.email"`
+	ActionEmailAuthMechanism                     string  `json:"action.email.auth_mechanism,omitempty" url:"action.email.auth_mechanism,omitempty"`
 	ActionEmailAuthPassword                      string  `json:"action.email.auth_password,omitempty" url:"action.email.auth_password,omitempty"`
 	ActionEmailAuthUsername                      string  `json:"action.email.auth_username,omitempty" url:"action.email.auth_username,omitempty"`
 	ActionEmailBCC                               string  `json:"action.email.bcc,omitempty" url:"action.email.bcc,omitempty"`
@@ -21,6 +34,7 @@ type SavedSearchObject struct {
 	ActionEmailCommand                           string  `json:"action.email.command,omitempty" url:"action.email.command,omitempty"`
 	ActionEmailFormat                            string  `json:"action.email.format,omitempty" url:"action.email.format,omitempty"`
 	ActionEmailFrom                              string  `json:"action.email.from,omitempty" url:"action.email.from,omitempty"`
+	ActionEmailGraceful                          bool    `json:"action.email.graceful" url:"action.email.graceful"`
 	ActionEmailHostname                          string  `json:"action.email.hostname,omitempty" url:"action.email.hostname,omitempty"`
 	ActionEmailIncludeResultsLink                int     `json:"action.email.include.results_link,string" url:"action.email.include.results_link"`
 	ActionEmailIncludeSearch                     int     `json:"action.email.include.search,string,omitempty" url:"action.email.include.search,omitempty"`
@@ -28,6 +42,7 @@ type SavedSearchObject struct {
 	ActionEmailIncludeTriggerTime                int     `json:"action.email.include.trigger_time,string,omitempty" url:"action.email.include.trigger_time,omitempty"`
 	ActionEmailIncludeViewLink                   int     `json:"action.email.include.view_link,string" url:"action.email.include.view_link"`
 	ActionEmailInline                            bool    `json:"action.email.inline" url:"action.email.inline"`
+	ActionEmailLocale                            string  `json:"action.email.locale,omitempty" url:"action.email.locale,omitempty"`
 	ActionEmailMailserver                        string  `json:"action.email.mailserver,omitempty" url:"action.email.mailserver,omitempty"`
 	ActionEmailMaxResults                        int     `json:"action.email.maxresults,omitempty" url:"action.email.maxresults,omitempty"`
 	ActionEmailMaxTime                           string  `json:"action.email.maxtime,omitempty" url:"action.email.maxtime,omitempty"`
@@ -36,6 +51,7 @@ type SavedSearchObject struct {
 	ActionEmailPDFView                           string  `json:"action.email.pdfview,omitempty" url:"action.email.pdfview,omitempty"`
 	ActionEmailPreprocessResults                 string  `json:"action.email.preprocess_results,omitempty" url:"action.email.preprocess_results,omitempty"`
 	ActionEmailReportCIDFontList                 string  `json:"action.email.reportCIDFontList,omitempty" url:"action.email.reportCIDFontList,omitempty"`
+	ActionEmailReportFileName                    string  `json:"action.email.reportFileName,omitempty" url:"action.email.reportFileName,omitempty"`
 	ActionEmailReportIncludeSplunkLogo           bool    `json:"action.email.reportIncludeSplunkLogo" url:"action.email.reportIncludeSplunkLogo"`
 	ActionEmailReportPaperOrientation            string  `json:"action.email.reportPaperOrientation,omitempty" url:"action.email.reportPaperOrientation,omitempty"`
 	ActionEmailReportPaperSize                   string  `json:"action.email.reportPaperSize,omitempty" url:"action.email.reportPaperSize,omitempty"`
@@ -49,6 +65,7 @@ type SavedSearchObject struct {
 	ActionEmailTo                                string  `json:"action.email.to,omitempty" url:"action.email.to,omitempty"`
 	ActionEmailTrackAlert                        bool    `json:"action.email.track_alert" url:"action.email.track_alert"`
 	ActionEmailTTL                               string  `json:"action.email.ttl,omitempty" url:"action.email.ttl,omitempty"`
+	ActionEmailUseNSSubject                      bool    `json:"action.email.useNSSubject" url:"action.email.useNSSubject"`
 	ActionEmailUseSSL                            bool    `json:"action.email.use_ssl" url:"action.email.use_ssl"`
 	ActionEmailUseTLS                            bool    `json:"action.email.use_tls" url:"action.email.use_tls"`
 	ActionEmailWidthSortColumns                  bool    `json:"action.email.width_sort_columns" url:"action.email.width_sort_columns"`
@@ -57,6 +74,10 @@ type SavedSearchObject struct {
 	ActionPagerdutyParamCustDetails              string  `json:"action.pagerduty.param.custom_details,omitempty" url:"action.pagerduty.param.custom_details,omitempty"`
 	ActionPagerdutyParamIntKey                   string  `json:"action.pagerduty.param.integration_key,omitempty" url:"action.pagerduty.param.integration_key,omitempty"`
 	ActionPagerdutyParamIntKeyOverride           string  `json:"action.pagerduty.param.integration_key_override,omitempty" url:"action.pagerduty.param.integration_key_override,omitempty"`
+	ActionRisk                                   bool    `json:"action.risk" url:"action.risk"`
+	ActionRiskParamRisk                          string  `json:"action.risk.param._risk,omitempty" url:"action.risk.param._risk,omitempty"`
+	ActionRiskParamRiskScore                     string  `json:"action.risk.param._risk_score,omitempty" url:"action.risk.param._risk_score,omitempty"`
+	ActionRiskParamRiskMessage                   string  `json:"action.risk.param._risk_message,omitempty" url:"action.risk.param._risk_message,omitempty"`
 	ActionScript                                 bool    `json:"-" url:"action.script"`
 	ActionScriptFilename                         string  `json:"action.script.filename,omitempty" url:"action.script.filename,omitempty"`
 	ActionSnowEventParamAccount                  string  `json:"action.snow_event.param.account,omitempty" url:"action.snow_event.param.account,omitempty"`
@@ -89,6 +110,27 @@ type SavedSearchObject struct {
 	ActionJiraServiceDeskParamJiraPriority       string  `json:"action.jira_service_desk.param.jira_priority,omitempty" url:"action.jira_service_desk.param.jira_priority"`
 	ActionJiraServiceDeskParamJiraDescription    string  `json:"action.jira_service_desk.param.jira_description,omitempty" url:"action.jira_service_desk.param.jira_description"`
 	ActionJiraServiceDeskParamJiraCustomfields   string  `json:"action.jira_service_desk.param.jira_customfields,omitempty" url:"action.jira_service_desk.param.jira_customfields"`
+	ActionDiscord                                bool    `json:"action.discord" url:"action.discord"`
+	ActionDiscordParamWebhookURL                 string  `json:"action.discord.param.webhook_url,omitempty" url:"action.discord.param.webhook_url,omitempty"`
+	ActionDiscordParamUsername                   string  `json:"action.discord.param.username,omitempty" url:"action.discord.param.username,omitempty"`
+	ActionDiscordParamMessage                    string  `json:"action.discord.param.message,omitempty" url:"action.discord.param.message,omitempty"`
+	ActionKeyindicator                           bool    `json:"action.keyindicator" url:"action.keyindicator"`
+	ActionKeyindicatorParamTitle                 string  `json:"action.keyindicator.param.title,omitempty" url:"action.keyindicator.param.title,omitempty"`
+	ActionKeyindicatorParamSubtitle              string  `json:"action.keyindicator.param.subtitle,omitempty" url:"action.keyindicator.param.subtitle,omitempty"`
+	ActionKeyindicatorParamValueSuffix           string  `json:"action.keyindicator.param.value_suffix,omitempty" url:"action.keyindicator.param.value_suffix,omitempty"`
+	ActionKeyindicatorParamInvert                string  `json:"action.keyindicator.param.invert,omitempty" url:"action.keyindicator.param.invert,omitempty"`
+	ActionKeyindicatorParamThreshold             string  `json:"action.keyindicator.param.threshold,omitempty" url:"action.keyindicator.param.threshold,omitempty"`
+	ActionKeyindicatorParamDrilldownURI          string  `json:"action.keyindicator.param.drilldown_uri,omitempty" url:"action.keyindicator.param.drilldown_uri,omitempty"`
+	ActionNotable                                bool    `json:"action.notable" url:"action.notable"`
+	ActionNotableParamRuleTitle                  string  `json:"action.notable.param.rule_title,omitempty" url:"action.notable.param.rule_title,omitempty"`
+	ActionNotableParamRuleDescription            string  `json:"action.notable.param.rule_description,omitempty" url:"action.notable.param.rule_description,omitempty"`
+	ActionNotableParamSecurityDomain             string  `json:"action.notable.param.security_domain,omitempty" url:"action.notable.param.security_domain,omitempty"`
+	ActionNotableParamSeverity                   string  `json:"action.notable.param.severity,omitempty" url:"action.notable.param.severity,omitempty"`
+	ActionNotableParamNesFields                  string  `json:"action.notable.param.nes_fields,omitempty" url:"action.notable.param.nes_fields,omitempty"`
+	ActionTelegram                               bool    `json:"action.telegram" url:"action.telegram"`
+	ActionTelegramParamBotToken                  string  `json:"action.telegram.param.bot_token,omitempty" url:"action.telegram.param.bot_token,omitempty"`
+	ActionTelegramParamChatID                    string  `json:"action.telegram.param.chat_id,omitempty" url:"action.telegram.param.chat_id,omitempty"`
+	ActionTelegramParamMessage                   string  `json:"action.telegram.param.message,omitempty" url:"action.telegram.param.message,omitempty"`
 	ActionWebhookParamUrl                        string  `json:"action.webhook.param.url,omitempty" url:"action.webhook.param.url"`
 	ActionWebhook                                bool    `json:"action.webhook" url:"action.webhook"`
 	ActionWebhookEnableAllowlist                 int     `json:"action.webhook.enable_allowlist" url:"action.webhook.enable_allowlist"`
@@ -103,6 +145,7 @@ type SavedSearchObject struct {
 	AlertSeverity                                int     `json:"alert.severity,omitempty" url:"alert.severity,omitempty"`
 	AlertSuppress                                bool    `json:"alert.suppress" url:"alert.suppress"`
 	AlertSuppressFields                          string  `json:"alert.suppress.fields,omitempty" url:"alert.suppress.fields,omitempty"`
+	AlertSuppressGroupName                       string  `json:"alert.suppress.group_name,omitempty" url:"alert.suppress.group_name,omitempty"`
 	AlertSuppressPeriod                          string  `json:"alert.suppress.period,omitempty" url:"alert.suppress.period,omitempty"`
 	AlertTrack                                   bool    `json:"alert.track" url:"alert.track"`
 	AlertComparator                              string  `json:"alert_comparator,omitempty" url:"alert_comparator,omitempty"`
@@ -143,6 +186,9 @@ type SavedSearchObject struct {
 	DispatchSpawnProcess                         bool    `json:"dispatch.spawn_process" url:"dispatch.spawn_process"`
 	DispatchTimeFormat                           string  `json:"dispatch.time_format,omitempty" url:"dispatch.time_format,omitempty"`
 	DispatchTTL                                  string  `json:"dispatch.ttl,omitempty" url:"dispatch.ttl,omitempty"`
+	DisplayGeneralColor                          string  `json:"display.general.color,omitempty" url:"display.general.color,omitempty"`
+	EmbedEnabled                                 bool    `json:"embed.enabled,omitempty" url:"-"`
+	EmbedToken                                   string  `json:"embed.token,omitempty" url:"-"`
 	DisplayView                                  string  `json:"displayview,omitempty" url:"displayview,omitempty"`
 	IsScheduled                                  bool    `json:"is_scheduled" url:"is_scheduled"`
 	IsVisible                                    bool    `json:"is_visible" url:"is_visible"`
@@ -157,6 +203,48 @@ type SavedSearchObject struct {
 	ScheduleWindow                               string  `json:"schedule_window,omitempty" url:"schedule_window,omitempty"`
 	SchedulePriority                             string  `json:"schedule_priority,omitempty" url:"schedule_priority,omitempty"`
 	Search                                       string  `json:"search,omitempty" url:"search,omitempty"`
+	SkippedCount                                 int     `json:"skipped_count,omitempty" url:"-"`
+	TriggeredAlertCount                          int     `json:"triggered_alert_count,omitempty" url:"-"`
 	VSID                                         string  `json:"vsid,omitempty" url:"vsid,omitempty"`
 	WorkloadPool                                 string  `json:"workload_pool,omitempty" url:"workload_pool,omitempty"`
 }
+
+type ParseSearchResponse struct {
+	Messages []ErrorMessage `json:"messages"`
+}
+
+type OneshotSearchResponse struct {
+	Results  []map[string]interface{} `json:"results"`
+	Messages []ErrorMessage           `json:"messages"`
+}
+
+type SearchJobsResponse struct {
+	Entry    []SearchJobsEntry `json:"entry"`
+	Messages []ErrorMessage    `json:"messages"`
+}
+
+type SearchJobsEntry struct {
+	Name    string          `json:"name"`
+	ACL     ACLObject       `json:"acl"`
+	Content SearchJobObject `json:"content"`
+}
+
+type SearchJobObject struct {
+	DispatchState string `json:"dispatchState"`
+	IsDone        bool   `json:"isDone"`
+}
+
+type SavedSearchSuppressionResponse struct {
+	Entry    []SavedSearchSuppressionEntry `json:"entry"`
+	Messages []ErrorMessage                `json:"messages"`
+}
+
+type SavedSearchSuppressionEntry struct {
+	Name    string                       `json:"name"`
+	Content SavedSearchSuppressionObject `json:"content"`
+}
+
+type SavedSearchSuppressionObject struct {
+	Suppressed int `json:"suppressed"`
+	Expiration int `json:"expiration"`
+}
@@ -0,0 +1,21 @@
+package models
+
+// Scheduled View Response Schema
+type ScheduledViewResponse struct {
+	Entry    []ScheduledViewEntry `json:"entry"`
+	Messages []ErrorMessage       `json:"messages"`
+}
+
+type ScheduledViewEntry struct {
+	Name    string              `json:"name"`
+	ACL     ACLObject           `json:"acl"`
+	Content ScheduledViewObject `json:"content"`
+}
+
+type ScheduledViewObject struct {
+	ActionEmail        bool   `json:"action.email" url:"action.email"`
+	ActionEmailSubject string `json:"action.email.subject,omitempty" url:"action.email.subject,omitempty"`
+	ActionEmailTo      string `json:"action.email.to,omitempty" url:"action.email.to,omitempty"`
+	CronSchedule       string `json:"cron_schedule,omitempty" url:"cron_schedule,omitempty"`
+	IsScheduled        bool   `json:"is_scheduled" url:"is_scheduled"`
+}
@@ -0,0 +1,19 @@
+package models
+
+type TagsResponse struct {
+	Entry    []TagsEntry    `json:"entry"`
+	Messages []ErrorMessage `json:"messages"`
+}
+
+type TagsEntry struct {
+	Name    string                 `json:"name"`
+	ACL     ACLObject              `json:"acl"`
+	Content map[string]interface{} `json:"content"`
+}
+
+// TagObject describes the tag assignments for one field::value pair.
+type TagObject struct {
+	Field string
+	Value string
+	Tags  []string
+}
@@ -0,0 +1,21 @@
+package models
+
+// Workload Pool Response Schema
+type WorkloadPoolResponse struct {
+	Entry    []WorkloadPoolEntry `json:"entry"`
+	Messages []ErrorMessage      `json:"messages"`
+}
+
+type WorkloadPoolEntry struct {
+	Name    string             `json:"name"`
+	ACL     ACLObject          `json:"acl"`
+	Content WorkloadPoolObject `json:"content"`
+}
+
+type WorkloadPoolObject struct {
+	Name                string `json:"name,omitempty" url:"name,omitempty"`
+	Category            string `json:"category,omitempty" url:"category,omitempty"`
+	CPUWeight           int    `json:"cpu_weight" url:"cpu_weight"`
+	MemWeight           int    `json:"mem_weight" url:"mem_weight"`
+	DefaultCategoryPool bool   `json:"default_category_pool" url:"default_category_pool"`
+}
@@ -1,11 +1,15 @@
 package client
 
 import (
-	"github.com/google/go-querystring/query"
-	"github.com/splunk/terraform-provider-splunk/client/models"
+	"encoding/json"
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-querystring/query"
+	"github.com/splunk/terraform-provider-splunk/client/models"
 )
 
 func (client *Client) CreateSavedSearches(name, owner, app string, savedSearchObject *models.SavedSearchObject) error {
@@ -32,6 +36,90 @@ func (client *Client) CreateSavedSearches(name, owner, app string, savedSearchOb
 	return nil
 }
 
+// ClearSavedSearchActionParams resets the given action parameter keys to empty
+// strings so parameters from a disabled action do not linger on the object.
+func (client *Client) ClearSavedSearchActionParams(name, owner, app string, keys []string) error {
+	values := url.Values{}
+	for _, key := range keys {
+		values.Set(key, "")
+	}
+
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "saved", "searches", name)
+	resp, err := client.Post(endpoint, []byte(values.Encode()))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// ParseSearch submits a search string to the parser endpoint without running
+// it, used to validate SPL syntax at plan time.
+func (client *Client) ParseSearch(search string) (*http.Response, error) {
+	values := url.Values{}
+	values.Set("q", search)
+	values.Set("parse_only", "t")
+
+	// A parse failure comes back as a 400, which the request helpers surface
+	// as an error; return the response alongside so callers can tell a parse
+	// error from an unreachable endpoint.
+	endpoint := client.BuildSplunkURL(nil, "services", "search", "parser")
+	return client.Post(endpoint, []byte(values.Encode()))
+}
+
+// OneshotSearch runs a search synchronously and returns the response with the
+// results inline, used for small utility queries like reading a lookup.
+func (client *Client) OneshotSearch(owner, app, search string) (*http.Response, error) {
+	values := url.Values{}
+	values.Set("search", search)
+	values.Set("exec_mode", "oneshot")
+
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "search", "jobs")
+	resp, err := client.Post(endpoint, []byte(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SetSavedSearchActionParams posts arbitrary action parameter keys, used for
+// dynamic parameters like webhook headers that have no fixed model field.
+// Empty values clear the corresponding key.
+func (client *Client) SetSavedSearchActionParams(name, owner, app string, params map[string]string) error {
+	values := url.Values{}
+	for key, value := range params {
+		values.Set(key, value)
+	}
+
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "saved", "searches", name)
+	resp, err := client.Post(endpoint, []byte(values.Encode()))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// DispatchSavedSearch triggers a one-off run of the saved search and returns
+// the SID of the dispatched search job.
+func (client *Client) DispatchSavedSearch(name, owner, app string) (string, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "saved", "searches", name, "dispatch")
+	resp, err := client.Post(endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	response := struct {
+		Sid string `json:"sid"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return "", err
+	}
+	return response.Sid, nil
+}
+
 func (client *Client) ReadSavedSearches(name, owner, app string) (*http.Response, error) {
 	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "saved", "searches", name)
 	resp, err := client.Get(endpoint)
@@ -42,6 +130,99 @@ func (client *Client) ReadSavedSearches(name, owner, app string) (*http.Response
 	return resp, nil
 }
 
+// ToggleSavedSearchEmbed enables or disables embedded reporting for a saved
+// search via its embed endpoint. Splunk generates the embed token server-side
+// when embedding is enabled.
+func (client *Client) ToggleSavedSearchEmbed(name, owner, app string, enabled bool) error {
+	values := url.Values{}
+	if enabled {
+		values.Set("embed.enabled", "1")
+	} else {
+		values.Set("embed.enabled", "0")
+	}
+
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "saved", "searches", name, "embed")
+	resp, err := client.Post(endpoint, []byte(values.Encode()))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// ReadUserSearchJobs lists the search jobs dispatched in the given user's
+// namespace, used to report the user's current search job usage.
+func (client *Client) ReadUserSearchJobs(owner string) (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, "-", "search", "jobs")
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// services/saved/searches
+func (client *Client) ReadAllSavedSearches() (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", "-", "-", "saved", "searches")
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ReadSavedSearchesPage fetches one page of saved searches starting at the
+// given offset.
+func (client *Client) ReadSavedSearchesPage(count, offset int) (*http.Response, error) {
+	queryValues := url.Values{}
+	queryValues.Set("count", strconv.Itoa(count))
+	queryValues.Set("offset", strconv.Itoa(offset))
+	endpoint := client.BuildSplunkURL(queryValues, "servicesNS", "-", "-", "saved", "searches")
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ReadSavedSearchesPaged pages through the saved searches list until every
+// entry has been fetched, respecting the server's paging.total. Search heads
+// with thousands of searches can truncate a single unbounded listing.
+func (client *Client) ReadSavedSearchesPaged(pageSize int) ([]models.SavedSearchesEntry, error) {
+	if pageSize <= 0 {
+		pageSize = 1000
+	}
+
+	entries := []models.SavedSearchesEntry{}
+	offset := 0
+	for {
+		resp, err := client.ReadSavedSearchesPage(pageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &models.SavedSearchesResponse{}
+		err = json.NewDecoder(resp.Body).Decode(response)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(response.Entry) == 0 {
+			break
+		}
+		entries = append(entries, response.Entry...)
+		offset += len(response.Entry)
+		if offset >= response.Paging.Total {
+			break
+		}
+	}
+	return entries, nil
+}
+
 func (client *Client) UpdateSavedSearches(name string, owner string, app string, savedSearchObject *models.SavedSearchObject) error {
 	values, err := query.Values(&savedSearchObject)
 	if err != nil {
@@ -72,3 +253,27 @@ func (client *Client) DeleteSavedSearches(name, owner, app string) (*http.Respon
 
 	return resp, nil
 }
+
+// GetSavedSearchSuppressionRemaining returns the number of seconds the saved
+// search's alert suppression has left, or zero when the search is not
+// currently suppressed.
+func (client *Client) GetSavedSearchSuppressionRemaining(name, owner, app string) (int, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "saved", "searches", name, "suppress")
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	response := &models.SavedSearchSuppressionResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return 0, err
+	}
+
+	for _, entry := range response.Entry {
+		if entry.Content.Suppressed != 0 {
+			return entry.Content.Expiration, nil
+		}
+	}
+	return 0, nil
+}
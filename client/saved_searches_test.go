@@ -0,0 +1,152 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGetSavedSearchSuppressionRemaining(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"entry":[{"name":"Test Alert","content":{"suppressed":1,"expiration":540}}]}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remaining, err := client.GetSavedSearchSuppressionRemaining("Test Alert", "nobody", "search")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := remaining, 540; got != want {
+		t.Errorf("suppression remaining invalid, got %d, want %d", got, want)
+	}
+}
+
+func TestDispatchSavedSearch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid":"scheduler__admin__search__RMD1234_at_1700000000_1"}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sid, err := client.DispatchSavedSearch("Test Alert", "nobody", "search")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := sid, "scheduler__admin__search__RMD1234_at_1700000000_1"; got != want {
+		t.Errorf("dispatched sid invalid, got %q, want %q", got, want)
+	}
+}
+
+func TestReadSavedSearchesPaged(t *testing.T) {
+	pages := map[string]string{
+		"0": `{"paging":{"total":3,"perPage":2,"offset":0},"entry":[{"name":"a"},{"name":"b"}]}`,
+		"2": `{"paging":{"total":3,"perPage":2,"offset":2},"entry":[{"name":"c"}]}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("count"), "2"; got != want {
+			t.Errorf("unexpected count, got %q, want %q", got, want)
+		}
+		page, ok := pages[r.URL.Query().Get("offset")]
+		if !ok {
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+		_, _ = w.Write([]byte(page))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := client.ReadSavedSearchesPaged(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries across pages, got %d", len(entries))
+	}
+	for i, want := range []string{"a", "b", "c"} {
+		if entries[i].Name != want {
+			t.Errorf("entry %d invalid, got %q, want %q", i, entries[i].Name, want)
+		}
+	}
+}
+
+func TestToggleSavedSearchEmbed(t *testing.T) {
+	var gotPath, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	c, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.ToggleSavedSearchEmbed("My Report", "admin", "search", true); err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/servicesNS/admin/search/saved/searches/My+Report/embed" {
+		t.Errorf("unexpected request path %q", gotPath)
+	}
+	if !strings.Contains(gotBody, "embed.enabled=1") {
+		t.Errorf("expected embed.enabled=1 in body, got %q", gotBody)
+	}
+
+	if err := c.ToggleSavedSearchEmbed("My Report", "admin", "search", false); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(gotBody, "embed.enabled=0") {
+		t.Errorf("expected embed.enabled=0 in body, got %q", gotBody)
+	}
+}
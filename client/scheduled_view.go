@@ -0,0 +1,44 @@
+package client
+
+import (
+	"log"
+	"net/http"
+	"net/http/httputil"
+
+	"github.com/google/go-querystring/query"
+	"github.com/splunk/terraform-provider-splunk/client/models"
+)
+
+func (client *Client) ReadScheduledView(name, owner, app string) (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "scheduled", "views", name)
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// UpdateScheduledView configures the schedule and email delivery of a view.
+// Splunk creates a scheduled view entity for every dashboard, so both the
+// resource create and update paths post to the existing entity.
+func (client *Client) UpdateScheduledView(name, owner, app string, scheduledViewObj *models.ScheduledViewObject) error {
+	values, err := query.Values(&scheduledViewObj)
+	if err != nil {
+		return err
+	}
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "scheduled", "views", name)
+	resp, err := client.Post(endpoint, values)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, error := httputil.DumpResponse(resp, true)
+	if error != nil {
+		log.Printf("[ERROR] Error occured during UpdateScheduledView %s", error)
+	}
+
+	log.Printf("[DEBUG] Response object returned from UpdateScheduledView is: %s", string(respBody))
+	return nil
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/splunk/terraform-provider-splunk/client/models"
+)
+
+// UpdateTags posts tag additions and deletions for a field::value pair against
+// the fields tagging endpoint.
+func (client *Client) UpdateTags(owner, app, field, value string, add, remove []string) error {
+	values := url.Values{}
+	values.Set("value", value)
+	for _, tag := range add {
+		values.Add("add", tag)
+	}
+	for _, tag := range remove {
+		values.Add("delete", tag)
+	}
+
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "search", "fields", field, "tags")
+	resp, err := client.Post(endpoint, []byte(values.Encode()))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (client *Client) CreateTags(owner, app string, tagObject *models.TagObject) error {
+	return client.UpdateTags(owner, app, tagObject.Field, tagObject.Value, tagObject.Tags, nil)
+}
+
+// ReadTags reads the conf-tags stanza backing the field::value pair, which
+// lists each assigned tag as an enabled key.
+func (client *Client) ReadTags(owner, app, field, value string) (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "configs", "conf-tags", field+"="+value)
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"net/http"
+)
+
+// ViewstateExists reports whether a viewstate with the given vsid exists under
+// the owner and app namespace. A 404 from the viewstates endpoint means the
+// vsid is dangling rather than an error.
+func (client *Client) ViewstateExists(vsid, owner, app string) (bool, error) {
+	endpoint := client.BuildSplunkURL(nil, "servicesNS", owner, app, "data", "ui", "viewstates", vsid)
+	resp, err := client.Get(endpoint)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
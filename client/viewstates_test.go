@@ -0,0 +1,50 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestViewstateExists(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "dangling") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"messages":[{"type":"ERROR","text":"Could not find object"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"entry":[{"name":"*:valid"}]}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewSplunkdClient("", defaultAuth, u.Host, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exists, err := client.ViewstateExists("valid", "nobody", "search")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Error("expected an existing vsid to resolve")
+	}
+
+	exists, err = client.ViewstateExists("dangling", "nobody", "search")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Error("expected a dangling vsid not to resolve")
+	}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/google/go-querystring/query"
+	"github.com/splunk/terraform-provider-splunk/client/models"
+)
+
+func (client *Client) CreateWorkloadPool(workloadPoolObject *models.WorkloadPoolObject) error {
+	values, err := query.Values(workloadPoolObject)
+	if err != nil {
+		return err
+	}
+
+	endpoint := client.BuildSplunkURL(nil, "services", "admin", "workload-pools")
+	resp, err := client.Post(endpoint, values)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (client *Client) ReadWorkloadPool(name string) (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "services", "admin", "workload-pools", name)
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (client *Client) UpdateWorkloadPool(name string, workloadPoolObject *models.WorkloadPoolObject) error {
+	values, err := query.Values(&workloadPoolObject)
+	if err != nil {
+		return err
+	}
+	// Not required for updating pool information
+	values.Del("name")
+	endpoint := client.BuildSplunkURL(nil, "services", "admin", "workload-pools", name)
+	resp, err := client.Post(endpoint, values)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (client *Client) DeleteWorkloadPool(name string) (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "services", "admin", "workload-pools", name)
+	resp, err := client.Delete(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// services/admin/workload-pools
+func (client *Client) ReadAllWorkloadPools() (*http.Response, error) {
+	endpoint := client.BuildSplunkURL(nil, "services", "admin", "workload-pools")
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -2,6 +2,9 @@ package splunk
 
 import (
 	"fmt"
+	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -36,7 +39,6 @@ func aclSchema() *schema.Schema {
 					Optional:     true,
 					Computed:     true,
 					ValidateFunc: validation.StringInSlice([]string{"user", "app", "global"}, false),
-					ForceNew:     true,
 					Description: "Indicates how the resource is shared. Required for updating any knowledge object ACL properties." +
 						"app: Shared within a specific app" +
 						"global: (Default) Shared globally to all apps." +
@@ -108,6 +110,14 @@ func aclValidator(diff *schema.ResourceDiff, v interface{}) error {
 		return fmt.Errorf("Value cannot be mapped to map!")
 	}
 
+	// Shared objects live in the nobody namespace; an owner naming a user is
+	// common in existing configs, so flag it without failing the plan.
+	if sharing, ok := aclMap["sharing"].(string); ok && sharing != "" && sharing != "user" {
+		if owner, ok := aclMap["owner"].(string); ok && owner != "" && owner != "nobody" {
+			log.Printf("[WARN] acl.owner %q is ignored for %q sharing; the object is managed in the nobody namespace", owner, sharing)
+		}
+	}
+
 	// Check if sharing has changed to "user"
 	if diff.HasChange("acl.0.sharing") {
 		_, new := diff.GetChange("acl.0.sharing")
@@ -125,9 +135,66 @@ func aclValidator(diff *schema.ResourceDiff, v interface{}) error {
 			}
 		}
 	}
+
+	return validateACLRoles(diff, v)
+}
+
+// validateACLRoles checks acl.read and acl.write entries against the roles the
+// server knows about, since a typoed role name is accepted by Splunk but
+// silently grants nothing. Validation is skipped with a warning when the role
+// list cannot be fetched.
+func validateACLRoles(diff *schema.ResourceDiff, v interface{}) error {
+	acl := diff.Get("acl").([]interface{})
+	if len(acl) == 0 {
+		return nil
+	}
+	aclMap, ok := acl[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	provider, ok := v.(*SplunkProvider)
+	if !ok || provider == nil {
+		return nil
+	}
+	roles, err := provider.validRoles()
+	if err != nil {
+		log.Printf("[WARN] Skipping acl role validation, unable to fetch roles: %s", err)
+		return nil
+	}
+
+	if unknown := unknownACLRoles(aclMap, roles); len(unknown) > 0 {
+		return fmt.Errorf("acl references unknown roles: %s", strings.Join(unknown, ", "))
+	}
 	return nil
 }
 
+// unknownACLRoles returns the acl.read and acl.write entries absent from the
+// given role set. The wildcard entry is always accepted.
+func unknownACLRoles(aclMap map[string]interface{}, roles map[string]bool) []string {
+	unknownSet := map[string]bool{}
+	for _, key := range []string{"read", "write"} {
+		perms, ok := aclMap[key].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, perm := range perms {
+			role, ok := perm.(string)
+			if !ok || role == "*" || roles[role] {
+				continue
+			}
+			unknownSet[role] = true
+		}
+	}
+
+	unknown := make([]string, 0, len(unknownSet))
+	for role := range unknownSet {
+		unknown = append(unknown, role)
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
 func getACLConfig(r []interface{}) (acl *models.ACLObject) {
 	acl = &models.ACLObject{}
 	for _, v := range r {
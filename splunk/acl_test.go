@@ -0,0 +1,39 @@
+package splunk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnknownACLRoles(t *testing.T) {
+	roles := map[string]bool{"admin": true, "power": true, "user": true}
+
+	aclMap := map[string]interface{}{
+		"read":  []interface{}{"admin", "user"},
+		"write": []interface{}{"admin"},
+	}
+	if unknown := unknownACLRoles(aclMap, roles); len(unknown) != 0 {
+		t.Errorf("expected no unknown roles, got %v", unknown)
+	}
+
+	aclMap = map[string]interface{}{
+		"read":  []interface{}{"*"},
+		"write": []interface{}{"admin"},
+	}
+	if unknown := unknownACLRoles(aclMap, roles); len(unknown) != 0 {
+		t.Errorf("expected the wildcard to always be accepted, got %v", unknown)
+	}
+
+	aclMap = map[string]interface{}{
+		"read":  []interface{}{"powr", "admin"},
+		"write": []interface{}{"adminn", "powr"},
+	}
+	expected := []string{"adminn", "powr"}
+	if unknown := unknownACLRoles(aclMap, roles); !reflect.DeepEqual(unknown, expected) {
+		t.Errorf("expected unknown roles %v, got %v", expected, unknown)
+	}
+
+	if unknown := unknownACLRoles(map[string]interface{}{}, roles); len(unknown) != 0 {
+		t.Errorf("expected an acl without perms to validate, got %v", unknown)
+	}
+}
@@ -0,0 +1,78 @@
+package splunk
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// dataSourceAllSavedSearches lists every saved search on the instance, paging
+// through the endpoint so search heads with thousands of searches are fully
+// enumerated. Per-resource reads still target their specific name.
+func dataSourceAllSavedSearches() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"page_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "Number of entries to request per page. Defaults to 1000.",
+			},
+			"searches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"app": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cron_schedule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+				Description: "Every saved search on the instance with its name, app, owner, cron_schedule and disabled state.",
+			},
+		},
+		Read: allSavedSearchesRead,
+	}
+}
+
+func allSavedSearchesRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+
+	entries, err := (*provider.Client).ReadSavedSearchesPaged(d.Get("page_size").(int))
+	if err != nil {
+		return err
+	}
+
+	searches := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		searches = append(searches, map[string]interface{}{
+			"name":          entry.Name,
+			"app":           entry.ACL.App,
+			"owner":         entry.ACL.Owner,
+			"cron_schedule": entry.Content.CronSchedule,
+			"disabled":      entry.Content.Disabled,
+		})
+	}
+
+	if err := d.Set("searches", searches); err != nil {
+		return err
+	}
+
+	d.SetId("all-saved-searches")
+	return nil
+}
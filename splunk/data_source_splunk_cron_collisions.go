@@ -0,0 +1,118 @@
+package splunk
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/splunk/terraform-provider-splunk/client/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// cronCollisions reports cron expressions shared by more searches than a
+// threshold, computed client-side. Many searches on the exact same schedule
+// stampede the scheduler; spreading them out evens the load.
+func cronCollisions() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Saved search names to inspect. When omitted, every scheduled search is considered.",
+			},
+			"threshold": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     2,
+				Description: "Minimum number of searches sharing a cron expression for it to be reported. Defaults to 2.",
+			},
+			"collisions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cron_schedule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				Description: "Cron expressions shared by at least threshold searches, with the search names using each.",
+			},
+		},
+		Read: cronCollisionsRead,
+	}
+}
+
+func cronCollisionsRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+
+	resp, err := (*provider.Client).ReadAllSavedSearches()
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	response := &models.SavedSearchesResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return err
+	}
+
+	names := []string{}
+	for _, name := range d.Get("names").([]interface{}) {
+		names = append(names, name.(string))
+	}
+
+	if err := d.Set("collisions", collisionsFromEntries(response.Entry, names, d.Get("threshold").(int))); err != nil {
+		return err
+	}
+
+	d.SetId("cron-collisions")
+	return nil
+}
+
+// collisionsFromEntries groups the scheduled searches by their exact cron
+// expression and keeps the groups reaching the threshold. An empty names list
+// considers every entry; otherwise only the named searches count.
+func collisionsFromEntries(entries []models.SavedSearchesEntry, names []string, threshold int) []interface{} {
+	selected := map[string]bool{}
+	for _, name := range names {
+		selected[name] = true
+	}
+
+	byCron := map[string][]string{}
+	for _, entry := range entries {
+		if len(selected) > 0 && !selected[entry.Name] {
+			continue
+		}
+		if !effectivelyScheduled(entry.Content.IsScheduled, entry.Content.Disabled, entry.Content.CronSchedule) {
+			continue
+		}
+		byCron[entry.Content.CronSchedule] = append(byCron[entry.Content.CronSchedule], entry.Name)
+	}
+
+	crons := make([]string, 0, len(byCron))
+	for cron, sharers := range byCron {
+		if len(sharers) >= threshold {
+			crons = append(crons, cron)
+		}
+	}
+	sort.Strings(crons)
+
+	collisions := make([]interface{}, 0, len(crons))
+	for _, cron := range crons {
+		sharers := byCron[cron]
+		sort.Strings(sharers)
+		collisions = append(collisions, map[string]interface{}{
+			"cron_schedule": cron,
+			"names":         sharers,
+		})
+	}
+	return collisions
+}
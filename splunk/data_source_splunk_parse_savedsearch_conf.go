@@ -0,0 +1,111 @@
+package splunk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// parseSavedsearchConf turns a savedsearches.conf stanza into the equivalent
+// resource attribute values, easing migration off conf-file-managed searches:
+// paste the stanza in, feed the attributes map into a splunk_saved_searches
+// resource.
+func parseSavedsearchConf() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"stanza": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A savedsearches.conf stanza, including the [name] header line.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The search name from the stanza header.",
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Stanza settings keyed by the matching splunk_saved_searches attribute name.",
+			},
+			"unmapped": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Stanza settings with no matching resource attribute, keyed by their conf name.",
+			},
+		},
+		Read: parseSavedsearchConfRead,
+	}
+}
+
+func parseSavedsearchConfRead(d *schema.ResourceData, meta interface{}) error {
+	name, settings, err := parseSavedSearchStanza(d.Get("stanza").(string))
+	if err != nil {
+		return err
+	}
+
+	resourceSchema := savedSearches().Schema
+	attributes := map[string]interface{}{}
+	unmapped := map[string]interface{}{}
+	for key, value := range settings {
+		attribute := strings.ReplaceAll(key, ".", "_")
+		if _, ok := resourceSchema[attribute]; ok {
+			attributes[attribute] = value
+		} else {
+			unmapped[key] = value
+		}
+	}
+
+	if err := d.Set("name", name); err != nil {
+		return err
+	}
+	if err := d.Set("attributes", attributes); err != nil {
+		return err
+	}
+	if err := d.Set("unmapped", unmapped); err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return nil
+}
+
+// parseSavedSearchStanza parses a single savedsearches.conf stanza into its
+// name and key/value settings. Comments are skipped and backslash line
+// continuations are joined, matching the conf file format.
+func parseSavedSearchStanza(text string) (string, map[string]string, error) {
+	var name string
+	settings := map[string]string{}
+
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		for strings.HasSuffix(line, "\\") && i+1 < len(lines) {
+			i++
+			line = strings.TrimSuffix(line, "\\") + strings.TrimSpace(lines[i])
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			if name != "" {
+				return "", nil, fmt.Errorf("expected a single stanza, found a second header %q", line)
+			}
+			name = strings.TrimSuffix(strings.TrimPrefix(line, "["), "]")
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return "", nil, fmt.Errorf("line %q is neither a stanza header nor a key = value setting", line)
+		}
+		settings[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	if name == "" {
+		return "", nil, fmt.Errorf("stanza is missing its [name] header line")
+	}
+	return name, settings, nil
+}
@@ -0,0 +1,46 @@
+package splunk
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// splunkRolesDataSource lists the role names known to the server, so acl
+// read/write entries can reference roles explicitly instead of repeating
+// hand-typed strings.
+func splunkRolesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names defined on the server, sorted alphabetically.",
+			},
+		},
+		Read: splunkRolesDataSourceRead,
+	}
+}
+
+func splunkRolesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+
+	roles, err := provider.validRoles()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(roles))
+	for name := range roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	d.SetId("roles")
+	return nil
+}
@@ -0,0 +1,69 @@
+package splunk
+
+import (
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// savedSearchCoverage reports which content keys Splunk returns for a saved
+// search that the provider does not map, so drift sources can be caught before
+// they show up as silent read gaps.
+func savedSearchCoverage() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the saved search to inspect.",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "nobody",
+				Description: "Namespace owner the saved search lives under. Defaults to nobody.",
+			},
+			"app": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "search",
+				Description: "App the saved search lives under. Defaults to search.",
+			},
+			"unmapped_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Content keys returned by Splunk that the provider does not map.",
+			},
+		},
+		Read: savedSearchCoverageRead,
+	}
+}
+
+func savedSearchCoverageRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	name := d.Get("name").(string)
+
+	resp, err := (*provider.Client).ReadSavedSearches(name, d.Get("owner").(string), d.Get("app").(string))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	unmapped := unmappedSavedSearchContentKeys(name, body)
+	keys := make([]interface{}, len(unmapped))
+	for i, key := range unmapped {
+		keys[i] = key
+	}
+	if err := d.Set("unmapped_keys", keys); err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return nil
+}
@@ -0,0 +1,150 @@
+package splunk
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/splunk/terraform-provider-splunk/client/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// schedulerForecast lists every scheduled saved search together with its
+// next run time, computed client-side from the cron schedule, as a sorted
+// timeline. Scheduling hotspots show up as clusters of entries with the same
+// next_run.
+func schedulerForecast() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"schedule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cron_schedule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_run": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "Upcoming runs of all scheduled searches, sorted by next_run (RFC 3339) then name.",
+			},
+		},
+		Read: schedulerForecastRead,
+	}
+}
+
+func schedulerForecastRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+
+	resp, err := (*provider.Client).ReadAllSavedSearches()
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	response := &models.SavedSearchesResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return err
+	}
+
+	if err := d.Set("schedule", forecastFromEntries(response.Entry, time.Now())); err != nil {
+		return err
+	}
+
+	d.SetId("scheduler-forecast")
+	return nil
+}
+
+// forecastFromEntries builds the sorted timeline for the searches that will
+// actually run: scheduled, enabled and carrying a cron schedule the provider
+// can expand. Entries with unexpandable schedules are skipped.
+func forecastFromEntries(entries []models.SavedSearchesEntry, from time.Time) []interface{} {
+	type upcoming struct {
+		name         string
+		cronSchedule string
+		nextRun      time.Time
+	}
+
+	var timeline []upcoming
+	for _, entry := range entries {
+		if !effectivelyScheduled(entry.Content.IsScheduled, entry.Content.Disabled, entry.Content.CronSchedule) {
+			continue
+		}
+		nextRun, ok := nextCronTime(entry.Content.CronSchedule, from)
+		if !ok {
+			continue
+		}
+		timeline = append(timeline, upcoming{entry.Name, entry.Content.CronSchedule, nextRun})
+	}
+
+	sort.Slice(timeline, func(i, j int) bool {
+		if !timeline[i].nextRun.Equal(timeline[j].nextRun) {
+			return timeline[i].nextRun.Before(timeline[j].nextRun)
+		}
+		return timeline[i].name < timeline[j].name
+	})
+
+	schedule := make([]interface{}, 0, len(timeline))
+	for _, run := range timeline {
+		schedule = append(schedule, map[string]interface{}{
+			"name":          run.name,
+			"cron_schedule": run.cronSchedule,
+			"next_run":      run.nextRun.Format(time.RFC3339),
+		})
+	}
+	return schedule
+}
+
+// nextCronTime computes the first time strictly after from that matches a
+// five-field cron expression, scanning at minute granularity. The second
+// return value is false when the expression cannot be expanded or nothing
+// matches within the next year.
+func nextCronTime(cron string, from time.Time) (time.Time, bool) {
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return time.Time{}, false
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+	sets := make([]map[int]bool, 5)
+	for i, field := range fields {
+		values, ok := expandCronField(field, bounds[i][0], bounds[i][1])
+		if !ok {
+			return time.Time{}, false
+		}
+		sets[i] = make(map[int]bool, len(values))
+		for _, value := range values {
+			sets[i][value] = true
+		}
+	}
+
+	limit := from.Add(366 * 24 * time.Hour)
+	for t := from.Truncate(time.Minute).Add(time.Minute); t.Before(limit); t = t.Add(time.Minute) {
+		if !sets[0][t.Minute()] || !sets[1][t.Hour()] || !sets[3][int(t.Month())] {
+			continue
+		}
+		// Standard cron semantics: when both day fields are restricted,
+		// matching either one is enough.
+		domOK, dowOK := sets[2][t.Day()], sets[4][int(t.Weekday())]
+		if fields[2] != "*" && fields[4] != "*" {
+			if !domOK && !dowOK {
+				continue
+			}
+		} else if !domOK || !dowOK {
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
@@ -1,10 +1,14 @@
 package splunk
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/splunk/terraform-provider-splunk/client"
+	"github.com/splunk/terraform-provider-splunk/client/models"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -12,6 +16,124 @@ import (
 
 type SplunkProvider struct {
 	Client *client.Client
+
+	// EmailGroups maps group names to their comma-separated member addresses,
+	// expanded into action_email_to by saved searches referencing the group.
+	EmailGroups map[string]string
+
+	// ValidateSearchSyntax runs saved search SPL through the parser endpoint
+	// at plan time when enabled.
+	ValidateSearchSyntax bool
+
+	rolesOnce sync.Once
+	roles     map[string]bool
+	rolesErr  error
+
+	emailLookupMu sync.Mutex
+	emailLookups  map[string]string
+
+	workloadPoolsOnce sync.Once
+	workloadPools     map[string]bool
+	workloadPoolsErr  error
+}
+
+// validWorkloadPools returns the set of workload pool names known to the
+// server, fetched once per provider instance like validRoles. The error is
+// surfaced so callers can skip validation on instances without workload
+// management.
+func (provider *SplunkProvider) validWorkloadPools() (map[string]bool, error) {
+	provider.workloadPoolsOnce.Do(func() {
+		resp, err := (*provider.Client).ReadAllWorkloadPools()
+		if err != nil {
+			provider.workloadPoolsErr = err
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != 200 {
+			provider.workloadPoolsErr = fmt.Errorf("workload pools endpoint returned status %d", resp.StatusCode)
+			return
+		}
+
+		response := &models.WorkloadPoolResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+			provider.workloadPoolsErr = err
+			return
+		}
+
+		provider.workloadPools = map[string]bool{}
+		for _, entry := range response.Entry {
+			provider.workloadPools[entry.Name] = true
+		}
+	})
+	return provider.workloadPools, provider.workloadPoolsErr
+}
+
+// emailLookupRecipients reads the recipient column of a CSV lookup through a
+// oneshot inputlookup, caching the joined list for the lifetime of the apply
+// so several searches sharing one distribution list read it once.
+func (provider *SplunkProvider) emailLookupRecipients(owner, app, lookup, column string) (string, error) {
+	key := app + "/" + lookup + "/" + column
+
+	provider.emailLookupMu.Lock()
+	defer provider.emailLookupMu.Unlock()
+	if recipients, ok := provider.emailLookups[key]; ok {
+		return recipients, nil
+	}
+
+	resp, err := (*provider.Client).OneshotSearch(owner, app, fmt.Sprintf("| inputlookup %q", lookup))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	response := &models.OneshotSearchResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return "", err
+	}
+
+	if len(response.Results) == 0 {
+		if len(response.Messages) > 0 {
+			return "", fmt.Errorf("unable to read recipient lookup %q: %s", lookup, response.Messages[0].Text)
+		}
+		return "", fmt.Errorf("recipient lookup %q returned no rows; does the lookup exist in app %q?", lookup, app)
+	}
+
+	recipients := joinLookupRecipients(response.Results, column)
+	if recipients == "" {
+		return "", fmt.Errorf("recipient lookup %q has no values in column %q", lookup, column)
+	}
+
+	if provider.emailLookups == nil {
+		provider.emailLookups = map[string]string{}
+	}
+	provider.emailLookups[key] = recipients
+	return recipients, nil
+}
+
+// validRoles returns the set of role names known to the server, fetched once
+// per provider instance and cached for the lifetime of the plan or apply.
+func (provider *SplunkProvider) validRoles() (map[string]bool, error) {
+	provider.rolesOnce.Do(func() {
+		resp, err := (*provider.Client).ReadAllAuthorizationRoles()
+		if err != nil {
+			provider.rolesErr = err
+			return
+		}
+		defer resp.Body.Close()
+
+		response := &models.AuthorizationRolesResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+			provider.rolesErr = err
+			return
+		}
+
+		provider.roles = map[string]bool{}
+		for _, entry := range response.Entry {
+			provider.roles[entry.Name] = true
+		}
+	})
+	return provider.roles, provider.rolesErr
 }
 
 func Provider() terraform.ResourceProvider {
@@ -24,7 +146,14 @@ func Provider() terraform.ResourceProvider {
 }
 
 func providerDataSources() map[string]*schema.Resource {
-	return map[string]*schema.Resource{}
+	return map[string]*schema.Resource{
+		"splunk_all_saved_searches":     dataSourceAllSavedSearches(),
+		"splunk_cron_collisions":        cronCollisions(),
+		"splunk_parse_savedsearch_conf": parseSavedsearchConf(),
+		"splunk_roles":                  splunkRolesDataSource(),
+		"splunk_saved_search_coverage":  savedSearchCoverage(),
+		"splunk_scheduler_forecast":     schedulerForecast(),
+	}
 }
 
 func providerSchema() map[string]*schema.Schema {
@@ -66,6 +195,51 @@ func providerSchema() map[string]*schema.Schema {
 			DefaultFunc: schema.EnvDefaultFunc("SPLUNK_TIMEOUT", 60),
 			Description: "Timeout when making calls to Splunk server. Defaults to 60 seconds",
 		},
+		"request_timeout": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SPLUNK_REQUEST_TIMEOUT", 0),
+			Description: "Deadline in seconds applied to each individual request, so Terraform cancellation " +
+				"propagates to in-flight calls. Defaults to 0 (no per-request deadline)",
+		},
+		"email_groups": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: "Map of group name to comma-separated email addresses. Saved searches can reference " +
+				"a group via action_email_to_groups and the provider expands it into action_email_to",
+		},
+		"validate_search_syntax": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SPLUNK_VALIDATE_SEARCH_SYNTAX", false),
+			Description: "Validate saved search SPL through the search parser endpoint at plan time. " +
+				"Defaults to false; parse errors surface during plan instead of apply",
+		},
+		"disable_compression": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SPLUNK_DISABLE_COMPRESSION", false),
+			Description: "Disable gzip response compression. Defaults to false; set when proxies mangle encoded responses",
+		},
+		"max_retries": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SPLUNK_MAX_RETRIES", 0),
+			Description: "Number of times to retry requests rejected with HTTP 429 or 503. Defaults to 0 (no retries)",
+		},
+		"retry_wait_min": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SPLUNK_RETRY_WAIT_MIN", 1),
+			Description: "Minimum wait in seconds between retries. Defaults to 1 second",
+		},
+		"retry_wait_max": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("SPLUNK_RETRY_WAIT_MAX", 30),
+			Description: "Maximum wait in seconds between retries. Defaults to 30 seconds",
+		},
 	}
 }
 
@@ -78,6 +252,7 @@ func providerResources() map[string]*schema.Resource {
 		"splunk_authorization_roles":         authorizationRoles(),
 		"splunk_global_http_event_collector": globalHttpEventCollector(),
 		"splunk_generic_acl":                 genericAcl(),
+		"splunk_hec_token":                   hecToken(),
 		"splunk_inputs_http_event_collector": inputsHttpEventCollector(),
 		"splunk_inputs_script":               inputsScript(),
 		"splunk_inputs_monitor":              inputsMonitor(),
@@ -87,13 +262,17 @@ func providerResources() map[string]*schema.Resource {
 		"splunk_inputs_tcp_splunk_tcp_token": inputsTCPSplunkTCPToken(),
 		"splunk_inputs_tcp_ssl":              inputsTCPSSL(),
 		"splunk_lookup_table_file":           lookupTableFile(),
+		"splunk_macro":                       splunkMacro(),
 		"splunk_outputs_tcp_default":         outputsTCPDefault(),
 		"splunk_outputs_tcp_server":          outputsTCPServer(),
 		"splunk_outputs_tcp_group":           outputsTCPGroup(),
 		"splunk_outputs_tcp_syslog":          outputsTCPSyslog(),
 		"splunk_saved_searches":              savedSearches(),
+		"splunk_scheduled_view":              splunkScheduledView(),
 		"splunk_lookup_definition":           splunkLookupDefinitions(),
 		"splunk_sh_indexes_manager":          shIndexesManager(),
+		"splunk_tags":                        splunkTags(),
+		"splunk_workload_pool":               workloadPool(),
 		"splunk_indexes":                     index(),
 		"splunk_configs_conf":                configsConf(),
 		"splunk_data_ui_views":               splunkDashboards(),
@@ -107,6 +286,13 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	provider := &SplunkProvider{}
 	var splunkdClient *client.Client
 
+	provider.ValidateSearchSyntax = d.Get("validate_search_syntax").(bool)
+
+	provider.EmailGroups = map[string]string{}
+	for group, addresses := range d.Get("email_groups").(map[string]interface{}) {
+		provider.EmailGroups[group] = addresses.(string)
+	}
+
 	httpClient, err := client.NewSplunkdHTTPClient(
 		time.Duration(d.Get("timeout").(int))*time.Second,
 		d.Get("insecure_skip_verify").(bool))
@@ -144,6 +330,20 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	splunkdClient.SetCompression(d.Get("disable_compression").(bool))
+
+	splunkdClient.SetRequestTimeout(time.Duration(d.Get("request_timeout").(int)) * time.Second)
+
+	splunkdClient.SetRetryPolicy(
+		d.Get("max_retries").(int),
+		time.Duration(d.Get("retry_wait_min").(int))*time.Second,
+		time.Duration(d.Get("retry_wait_max").(int))*time.Second)
+
+	// Fail fast with one actionable message instead of confusing per-resource errors
+	if err := splunkdClient.VerifyConnectivity(); err != nil {
+		return nil, err
+	}
+
 	provider.Client = splunkdClient
 	return provider, nil
 }
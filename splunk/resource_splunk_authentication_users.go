@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"github.com/splunk/terraform-provider-splunk/client/models"
 	"net/http"
-	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -216,9 +215,10 @@ func getAuthenticationUserByName(name string, httpResponse *http.Response) (Auth
 	switch httpResponse.StatusCode {
 	case 200, 201:
 		_ = json.NewDecoder(httpResponse.Body).Decode(&response)
-		re := regexp.MustCompile(`(.*)`)
+		// The listing can contain users whose names share a prefix, so require
+		// an exact match rather than a pattern.
 		for _, entry := range response.Entry {
-			if name == re.FindStringSubmatch(entry.Name)[1] {
+			if name == entry.Name {
 				return &entry, nil
 			}
 		}
@@ -6,9 +6,9 @@ import (
 	"fmt"
 	"github.com/splunk/terraform-provider-splunk/client/models"
 	"net/http"
-	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func authorizationRoles() *schema.Resource {
@@ -48,7 +48,7 @@ func authorizationRoles() *schema.Resource {
 				Description: "Specify the folder name of the default app to use for this role. A user-specific default app overrides this.",
 			},
 			"imported_roles": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
 				Elem: &schema.Schema{
@@ -66,10 +66,11 @@ func authorizationRoles() *schema.Resource {
 				Description: "Specify the maximum number of concurrent real-time search jobs for this role. This count is independent from the normal search jobs limit.",
 			},
 			"search_disk_quota": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     100,
-				Description: "Specifies the maximum disk space in MB that can be used by a user's search jobs. For example, a value of 100 limits this role to 100 MB total.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Specifies the maximum disk space in MB that can be used by a user's search jobs. For example, a value of 100 limits this role to 100 MB total.",
 			},
 			"search_filter": {
 				Type:     schema.TypeString,
@@ -79,7 +80,7 @@ func authorizationRoles() *schema.Resource {
 					"Search results for this role only show events that also match the search string you specify. In the case that a user has multiple roles with different search filters, they are combined with an OR.",
 			},
 			"search_indexes_allowed": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
 				Elem: &schema.Schema{
@@ -99,7 +100,7 @@ func authorizationRoles() *schema.Resource {
 					"You can also specify other search indexes added to the server. ",
 			},
 			"search_indexes_default": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
 				Elem: &schema.Schema{
@@ -291,7 +292,7 @@ func getAuthorizationRolesConfig(d *schema.ResourceData) (authenticationUserObje
 	authenticationUserObject.CumulativeSrchJobsQuota = d.Get("cumulative_search_jobs_quota").(int)
 	authenticationUserObject.DefaultApp = d.Get("default_app").(string)
 	if val, ok := d.GetOk("imported_roles"); ok {
-		for _, v := range val.([]interface{}) {
+		for _, v := range val.(*schema.Set).List() {
 			authenticationUserObject.ImportedRoles = append(authenticationUserObject.ImportedRoles, v.(string))
 		}
 	}
@@ -300,12 +301,12 @@ func getAuthorizationRolesConfig(d *schema.ResourceData) (authenticationUserObje
 	authenticationUserObject.SrchDiskQuota = d.Get("search_disk_quota").(int)
 	authenticationUserObject.SrchFilter = d.Get("search_filter").(string)
 	if val, ok := d.GetOk("search_indexes_allowed"); ok {
-		for _, v := range val.([]interface{}) {
+		for _, v := range val.(*schema.Set).List() {
 			authenticationUserObject.SrchIndexesAllowed = append(authenticationUserObject.SrchIndexesAllowed, v.(string))
 		}
 	}
 	if val, ok := d.GetOk("search_indexes_default"); ok {
-		for _, v := range val.([]interface{}) {
+		for _, v := range val.(*schema.Set).List() {
 			authenticationUserObject.SrchIndexesDefault = append(authenticationUserObject.SrchIndexesDefault, v.(string))
 		}
 	}
@@ -319,9 +320,10 @@ func getAuthorizationRolesByName(name string, httpResponse *http.Response) (Auth
 	switch httpResponse.StatusCode {
 	case 200, 201:
 		_ = json.NewDecoder(httpResponse.Body).Decode(&response)
-		re := regexp.MustCompile(`(.*)`)
+		// Role names can share a prefix, so require an exact match rather than
+		// a pattern.
 		for _, entry := range response.Entry {
-			if name == re.FindStringSubmatch(entry.Name)[1] {
+			if name == entry.Name {
 				return &entry, nil
 			}
 		}
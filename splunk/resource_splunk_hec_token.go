@@ -0,0 +1,220 @@
+package splunk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/splunk/terraform-provider-splunk/client/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// HEC tokens always live in the splunk_httpinput app under the nobody owner
+const (
+	hecTokenOwner = "nobody"
+	hecTokenApp   = "splunk_httpinput"
+)
+
+func hecToken() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Required. Token name (inputs.conf key)",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Token value for sending data to collector/event endpoint.",
+			},
+			"index": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Index to store generated events",
+			},
+			"indexes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of indexes allowed for events with this token.",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default source for events with this token",
+			},
+			"sourcetype": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default sourcetype for events with this token",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Input disabled indicator: 0 = Input Not disabled, 1 = Input disabled",
+			},
+			"rotate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flip this value to regenerate the token on the next apply.",
+			},
+		},
+		Read:   hecTokenRead,
+		Create: hecTokenCreate,
+		Delete: hecTokenDelete,
+		Update: hecTokenUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+// Functions
+func hecTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	name := d.Get("name").(string)
+	hecTokenObj := getHECTokenConfig(d)
+	err := (*provider.Client).CreateHECTokenObject(name, hecTokenOwner, hecTokenApp, hecTokenObj)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return hecTokenRead(d, meta)
+}
+
+func hecTokenRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	name := d.Id()
+	resp, err := (*provider.Client).ReadHECTokenObject(name, hecTokenOwner, hecTokenApp)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	entry, err := getHECTokenByName(name, resp)
+	if err != nil {
+		return err
+	}
+
+	if entry == nil {
+		return fmt.Errorf("Unable to find resource: %v", name)
+	}
+
+	if err = d.Set("name", d.Id()); err != nil {
+		return err
+	}
+
+	if err = d.Set("token", entry.Content.Token); err != nil {
+		return err
+	}
+
+	if err = d.Set("index", entry.Content.Index); err != nil {
+		return err
+	}
+
+	if err = d.Set("indexes", entry.Content.Indexes); err != nil {
+		return err
+	}
+
+	if err = d.Set("source", entry.Content.Source); err != nil {
+		return err
+	}
+
+	if err = d.Set("sourcetype", entry.Content.SourceType); err != nil {
+		return err
+	}
+
+	if err = d.Set("disabled", entry.Content.Disabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func hecTokenUpdate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	if d.HasChange("rotate") {
+		err := (*provider.Client).RotateHECTokenObject(d.Id(), hecTokenOwner, hecTokenApp)
+		if err != nil {
+			return err
+		}
+	}
+
+	hecTokenObj := getHECTokenConfig(d)
+	err := (*provider.Client).UpdateHECTokenObject(d.Id(), hecTokenOwner, hecTokenApp, hecTokenObj)
+	if err != nil {
+		return err
+	}
+
+	return hecTokenRead(d, meta)
+}
+
+func hecTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	resp, err := (*provider.Client).DeleteHECTokenObject(d.Id(), hecTokenOwner, hecTokenApp)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 200, 201:
+		return nil
+
+	default:
+		errorResponse := &models.HECTokenResponse{}
+		_ = json.NewDecoder(resp.Body).Decode(errorResponse)
+		err := errors.New(errorResponse.Messages[0].Text)
+		return err
+	}
+}
+
+// Helpers
+func getHECTokenConfig(d *schema.ResourceData) (hecTokenObject *models.HECTokenObject) {
+	hecTokenObject = &models.HECTokenObject{}
+	hecTokenObject.Index = d.Get("index").(string)
+	hecTokenObject.Indexes = d.Get("indexes").([]interface{})
+	hecTokenObject.Source = d.Get("source").(string)
+	hecTokenObject.SourceType = d.Get("sourcetype").(string)
+	hecTokenObject.Disabled = d.Get("disabled").(bool)
+	return hecTokenObject
+}
+
+func getHECTokenByName(name string, httpResponse *http.Response) (hecTokenEntry *models.HECTokenEntry, err error) {
+	response := &models.HECTokenResponse{}
+	switch httpResponse.StatusCode {
+	case 200, 201:
+		decoder := json.NewDecoder(httpResponse.Body)
+		err := decoder.Decode(response)
+		if err != nil {
+			return nil, err
+		}
+		// Entry names come back prefixed with the input type; require an
+		// exact match on the remainder rather than a pattern.
+		for _, entry := range response.Entry {
+			if name == strings.TrimPrefix(entry.Name, "http://") {
+				return &entry, nil
+			}
+		}
+
+	default:
+		_ = json.NewDecoder(httpResponse.Body).Decode(response)
+		err := errors.New(response.Messages[0].Text)
+		return hecTokenEntry, err
+	}
+
+	return hecTokenEntry, nil
+}
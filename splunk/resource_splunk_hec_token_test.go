@@ -0,0 +1,103 @@
+package splunk
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+const newHECToken = `
+resource "splunk_global_http_event_collector" "http" {
+  disabled     = false
+  enable_ssl   = true
+}
+
+resource "splunk_hec_token" "app-token" {
+    name = "app-token"
+    source = "new"
+    disabled = false
+
+    depends_on = ["splunk_global_http_event_collector.http"]
+}
+`
+
+const updateHECToken = `
+resource "splunk_global_http_event_collector" "http" {
+  disabled     = false
+  enable_ssl   = true
+}
+
+resource "splunk_hec_token" "app-token" {
+    name = "app-token"
+    index = "main"
+    indexes = ["main", "history"]
+    source = "new"
+    sourcetype = "new"
+    disabled = false
+    rotate = true
+
+    depends_on = ["splunk_global_http_event_collector.http"]
+}
+`
+
+func TestAccSplunkHECToken(t *testing.T) {
+	resourceName := "splunk_hec_token.app-token"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSplunkHECTokenDestroyResources,
+		Steps: []resource.TestStep{
+			{
+				Config: newHECToken,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "source", "new"),
+					resource.TestCheckResourceAttr(resourceName, "index", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+			{
+				Config: updateHECToken,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "source", "new"),
+					resource.TestCheckResourceAttr(resourceName, "index", "main"),
+					resource.TestCheckResourceAttr(resourceName, "sourcetype", "new"),
+					resource.TestCheckResourceAttr(resourceName, "indexes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "indexes.0", "main"),
+					resource.TestCheckResourceAttr(resourceName, "indexes.1", "history"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+			{
+				ResourceName:            "splunk_hec_token.app-token",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate"},
+			},
+		},
+	})
+}
+
+func testAccSplunkHECTokenDestroyResources(s *terraform.State) error {
+	client, err := newTestClient()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "splunk_hec_token":
+			endpoint := client.BuildSplunkURL(nil, "servicesNS", "nobody", "splunk_httpinput", "data", "inputs", "http", rs.Primary.ID)
+			resp, err := client.Get(endpoint)
+			if resp.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("error: %s: %s", rs.Primary.ID, err)
+			}
+		}
+	}
+	return nil
+}
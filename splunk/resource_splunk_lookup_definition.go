@@ -14,7 +14,7 @@ import (
 
 func splunkLookupDefinitions() *schema.Resource {
 	return &schema.Resource{
-		CustomizeDiff: aclValidator,
+		CustomizeDiff: splunkLookupDefinitionsCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -24,8 +24,30 @@ func splunkLookupDefinitions() *schema.Resource {
 			},
 			"filename": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the static lookup table file",
+				Optional:    true,
+				Description: "The name of the static lookup table file. Exactly one of filename, collection or external_cmd must be set.",
+			},
+			"collection": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the KV store collection backing the lookup. Exactly one of filename, collection or external_cmd must be set.",
+			},
+			"external_cmd": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Command and arguments for an external (scripted) lookup. Exactly one of filename, collection or external_cmd must be set.",
+			},
+			"fields_list": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Comma-separated list of the fields in the lookup",
+			},
+			"max_matches": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of matches for each input lookup value",
 			},
 			"acl": aclSchema(),
 		},
@@ -34,11 +56,54 @@ func splunkLookupDefinitions() *schema.Resource {
 		Delete: splunkLookupDefinitionsDelete,
 		Update: splunkLookupDefinitionsUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: splunkLookupDefinitionsImport,
 		},
 	}
 }
 
+func splunkLookupDefinitionsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := aclValidator(d, meta); err != nil {
+		return err
+	}
+	return validateLookupDefinitionSource(d.Get("filename").(string), d.Get("collection").(string), d.Get("external_cmd").(string))
+}
+
+// validateLookupDefinitionSource enforces that exactly one lookup backing is
+// configured: a static file, a KV store collection or an external command.
+func validateLookupDefinitionSource(filename, collection, externalCmd string) error {
+	configured := 0
+	for _, source := range []string{filename, collection, externalCmd} {
+		if source != "" {
+			configured++
+		}
+	}
+	if configured != 1 {
+		return errors.New("exactly one of filename, collection or external_cmd must be set")
+	}
+	return nil
+}
+
+// splunkLookupDefinitionsImport accepts either a bare lookup name or an
+// app/owner/name triple, seeding the acl block from the latter.
+func splunkLookupDefinitionsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	app, owner, name, err := parseSavedSearchImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(name)
+	if app != "" {
+		err := d.Set("acl", []interface{}{map[string]interface{}{
+			"app":   app,
+			"owner": owner,
+		}})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 // Functions
 func splunkLookupDefinitionsCreate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*SplunkProvider)
@@ -86,6 +151,22 @@ func splunkLookupDefinitionsRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if err = d.Set("collection", entry.Content.Collection); err != nil {
+		return err
+	}
+
+	if err = d.Set("external_cmd", entry.Content.ExternalCmd); err != nil {
+		return err
+	}
+
+	if err = d.Set("fields_list", entry.Content.FieldsList); err != nil {
+		return err
+	}
+
+	if err = d.Set("max_matches", entry.Content.MaxMatches); err != nil {
+		return err
+	}
+
 	err = d.Set("acl", flattenACL(&entry.ACL))
 	if err != nil {
 		return err
@@ -172,5 +253,13 @@ func getSplunkLookupDefinitionConfig(d *schema.ResourceData) (splunkLookupDefini
 	splunkLookupDefinitionObject = &models.SplunkLookupDefinitionObject{}
 	splunkLookupDefinitionObject.Name = d.Get("name").(string)
 	splunkLookupDefinitionObject.Filename = d.Get("filename").(string)
+	splunkLookupDefinitionObject.Collection = d.Get("collection").(string)
+	splunkLookupDefinitionObject.ExternalCmd = d.Get("external_cmd").(string)
+	splunkLookupDefinitionObject.FieldsList = d.Get("fields_list").(string)
+	splunkLookupDefinitionObject.MaxMatches = d.Get("max_matches").(int)
+	// KV store backed lookups must declare their external type
+	if splunkLookupDefinitionObject.Collection != "" {
+		splunkLookupDefinitionObject.ExternalType = "kvstore"
+	}
 	return splunkLookupDefinitionObject
 }
@@ -237,3 +237,21 @@ func testAccSplunkLookupDefinitionDestroyResources(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestValidateLookupDefinitionSource(t *testing.T) {
+	if err := validateLookupDefinitionSource("lookup.csv", "", ""); err != nil {
+		t.Errorf("expected file-backed lookup to validate, got %v", err)
+	}
+	if err := validateLookupDefinitionSource("", "my_collection", ""); err != nil {
+		t.Errorf("expected KV-backed lookup to validate, got %v", err)
+	}
+	if err := validateLookupDefinitionSource("", "", "lookup.py field1 field2"); err != nil {
+		t.Errorf("expected external lookup to validate, got %v", err)
+	}
+	if err := validateLookupDefinitionSource("", "", ""); err == nil {
+		t.Error("expected an error when no backing is configured")
+	}
+	if err := validateLookupDefinitionSource("lookup.csv", "my_collection", ""); err == nil {
+		t.Error("expected an error when multiple backings are configured")
+	}
+}
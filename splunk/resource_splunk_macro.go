@@ -0,0 +1,244 @@
+package splunk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/splunk/terraform-provider-splunk/client/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func splunkMacro() *schema.Resource {
+	return &schema.Resource{
+		CustomizeDiff: aclValidator,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Macro name. For a macro that takes arguments, append the argument count " +
+					"in parentheses, for example mymacro(2). Changing the arity requires recreation.",
+			},
+			"definition": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The string the macro expands to, with arguments substituted where $arg$ tokens appear.",
+			},
+			"arguments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated list of argument names. Only applies to macros declared with an arity suffix.",
+			},
+			"validation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Eval expression that evaluates to true if the provided arguments are valid.",
+			},
+			"errormsg": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Error message returned when the validation expression evaluates to false.",
+			},
+			"iseval": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the definition is an eval expression returning the expansion rather than the expansion itself.",
+			},
+			"acl": aclSchema(),
+		},
+		Read:   splunkMacroRead,
+		Create: splunkMacroCreate,
+		Delete: splunkMacroDelete,
+		Update: splunkMacroUpdate,
+		Importer: &schema.ResourceImporter{
+			State: splunkMacroImport,
+		},
+	}
+}
+
+// splunkMacroImport accepts either a bare macro name or an app/owner/name
+// triple, seeding the acl block from the latter.
+func splunkMacroImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	app, owner, name, err := parseSavedSearchImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(name)
+	if app != "" {
+		err := d.Set("acl", []interface{}{map[string]interface{}{
+			"app":   app,
+			"owner": owner,
+		}})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// Functions
+func splunkMacroCreate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	macroObj := getSplunkMacroConfig(d)
+	aclObject := getResourceDataViewACL(d)
+	name := d.Get("name").(string)
+
+	err := (*provider.Client).CreateMacroObject(aclObject.Owner, aclObject.App, macroObj)
+	if err != nil {
+		return err
+	}
+	if err := (*provider.Client).UpdateAcl(aclObject.Owner, aclObject.App, name, aclObject, "admin", "macros"); err != nil {
+		return err
+	}
+	d.SetId(name)
+	return splunkMacroRead(d, meta)
+}
+
+func splunkMacroRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	aclObject := getResourceDataViewACL(d)
+	name := d.Id()
+	readUser := aclObject.Owner
+
+	resp, err := (*provider.Client).ReadMacroObject(name, readUser, aclObject.App)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	entry, err := getMacroByName(name, resp)
+	if err != nil {
+		return err
+	}
+
+	if entry == nil {
+		return fmt.Errorf("unable to find resource: %v", name)
+	}
+
+	if err = d.Set("name", entry.Name); err != nil {
+		return err
+	}
+
+	if err = d.Set("definition", entry.Content.Definition); err != nil {
+		return err
+	}
+
+	if err = d.Set("arguments", entry.Content.Arguments); err != nil {
+		return err
+	}
+
+	if err = d.Set("validation", entry.Content.Validation); err != nil {
+		return err
+	}
+
+	if err = d.Set("errormsg", entry.Content.Errormsg); err != nil {
+		return err
+	}
+
+	if err = d.Set("iseval", entry.Content.Iseval == "1"); err != nil {
+		return err
+	}
+
+	err = d.Set("acl", flattenACL(&entry.ACL))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func splunkMacroUpdate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	name := d.Get("name").(string)
+	macroObj := getSplunkMacroConfig(d)
+	aclObject := getResourceDataViewACL(d)
+
+	updateUser := "nobody"
+
+	if aclObject.Sharing == "user" {
+		updateUser = aclObject.Owner
+	}
+
+	if err := (*provider.Client).UpdateMacroObject(updateUser, aclObject.App, name, macroObj); err != nil {
+		return err
+	}
+
+	if err := (*provider.Client).UpdateAcl(updateUser, aclObject.App, name, aclObject, "admin", "macros"); err != nil {
+		return err
+	}
+
+	return splunkMacroRead(d, meta)
+}
+
+func splunkMacroDelete(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	name := d.Id()
+	aclObject := getResourceDataViewACL(d)
+	if aclObject.Sharing != "user" {
+		aclObject.Owner = "nobody"
+	}
+	resp, err := (*provider.Client).DeleteMacroObject(aclObject.Owner, aclObject.App, name)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 200, 201:
+		return nil
+
+	default:
+		errorResponse := &models.MacroResponse{}
+		_ = json.NewDecoder(resp.Body).Decode(errorResponse)
+		err := errors.New(errorResponse.Messages[0].Text)
+		return err
+	}
+}
+
+func getMacroByName(name string, httpResponse *http.Response) (macroEntry *models.MacroEntry, err error) {
+	response := &models.MacroResponse{}
+	switch httpResponse.StatusCode {
+	case 200, 201:
+
+		decoder := json.NewDecoder(httpResponse.Body)
+		err := decoder.Decode(response)
+		if err != nil {
+			return nil, err
+		}
+		// The listing can contain macros whose names share a prefix, so
+		// require an exact match rather than a pattern.
+		for _, entry := range response.Entry {
+			if name == entry.Name {
+				return &entry, nil
+			}
+		}
+
+	default:
+		_ = json.NewDecoder(httpResponse.Body).Decode(response)
+		err := errors.New(response.Messages[0].Text)
+		return macroEntry, err
+	}
+
+	return macroEntry, nil
+}
+
+func getSplunkMacroConfig(d *schema.ResourceData) (macroObject *models.MacroObject) {
+	macroObject = &models.MacroObject{}
+	macroObject.Name = d.Get("name").(string)
+	macroObject.Definition = d.Get("definition").(string)
+	macroObject.Arguments = d.Get("arguments").(string)
+	macroObject.Validation = d.Get("validation").(string)
+	macroObject.Errormsg = d.Get("errormsg").(string)
+	// The macros endpoint expects iseval as a conf-style 0/1 flag
+	if d.Get("iseval").(bool) {
+		macroObject.Iseval = "1"
+	} else {
+		macroObject.Iseval = "0"
+	}
+	return macroObject
+}
@@ -0,0 +1,83 @@
+package splunk
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const splunkMacroObject = `
+resource "splunk_macro" "example" {
+  name       = "example_macro(1)"
+  definition = "index=_internal sourcetype=$sourcetype$"
+  arguments  = "sourcetype"
+}
+`
+
+const updateSplunkMacroObject = `
+resource "splunk_macro" "example" {
+  name       = "example_macro(1)"
+  definition = "index=_internal sourcetype=$sourcetype$ | head 100"
+  arguments  = "sourcetype"
+  validation = "match(sourcetype, \"^[a-z_]+$\")"
+  errormsg   = "sourcetype must be lowercase"
+}
+`
+
+func TestAccSplunkMacro(t *testing.T) {
+	resourceName := "splunk_macro.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSplunkMacroDestroyResources,
+		Steps: []resource.TestStep{
+			{
+				Config: splunkMacroObject,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "example_macro(1)"),
+					resource.TestCheckResourceAttr(resourceName, "definition", "index=_internal sourcetype=$sourcetype$"),
+					resource.TestCheckResourceAttr(resourceName, "arguments", "sourcetype"),
+					resource.TestCheckResourceAttr(resourceName, "iseval", "false"),
+				),
+			},
+			{
+				Config: updateSplunkMacroObject,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "example_macro(1)"),
+					resource.TestCheckResourceAttr(resourceName, "definition", "index=_internal sourcetype=$sourcetype$ | head 100"),
+					resource.TestCheckResourceAttr(resourceName, "validation", "match(sourcetype, \"^[a-z_]+$\")"),
+					resource.TestCheckResourceAttr(resourceName, "errormsg", "sourcetype must be lowercase"),
+				),
+			},
+			{
+				ResourceName:      "splunk_macro.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSplunkMacroDestroyResources(s *terraform.State) error {
+	client, err := newTestClient()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "splunk_macro":
+			endpoint := client.BuildSplunkURL(nil, "servicesNS", "admin", "search", "admin", "macros", rs.Primary.ID)
+			resp, err := client.Get(endpoint)
+			if resp.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("error: %s: %s", rs.Primary.ID, err)
+			}
+		}
+	}
+	return nil
+}
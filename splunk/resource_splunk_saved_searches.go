@@ -4,23 +4,335 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"net/mail"
+	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/avast/retry-go/v4"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/splunk/terraform-provider-splunk/client/models"
 )
 
+// defaultAlertExpires is the server-side default for alert.expires. Kept as a
+// named constant so it can be adjusted in one place if a Splunk version
+// changes the default.
+const defaultAlertExpires = "24h"
+
 func suppressDefault(defaultValue string) schema.SchemaDiffSuppressFunc {
 	return func(k, old, new string, d *schema.ResourceData) bool {
 		return old == defaultValue && new == ""
 	}
 }
 
+// serverDefaultActionParams maps action parameter attributes to the value
+// Splunk fills in automatically when the corresponding action is enabled.
+// Drift on these is suppressed only while the configuration leaves the
+// attribute unset; an explicit value always diffs normally. Entries can be
+// overridden per resource via server_default_action_params.
+var serverDefaultActionParams = map[string]string{
+	"action_email_format":                   "table",
+	"action_email_report_paper_orientation": "portrait",
+	"action_email_report_paper_size":        "letter",
+	"action_discord_param_username":         "Splunk",
+}
+
+// suppressServerAddedDefault suppresses drift on action parameters the server
+// populated with a known default the configuration never set.
+func suppressServerAddedDefault(k, old, new string, d *schema.ResourceData) bool {
+	if new != "" {
+		return false
+	}
+	defaultValue, known := serverDefaultActionParams[k]
+	if overrides, ok := d.GetOk("server_default_action_params"); ok {
+		if override, ok := overrides.(map[string]interface{})[k]; ok {
+			defaultValue, known = override.(string), true
+		}
+	}
+	return known && old == defaultValue
+}
+
+// isRealtimeSearch reports whether the configured dispatch window makes this
+// a real-time search. Splunk marks real-time windows with an rt prefix.
+func isRealtimeSearch(earliest, latest string) bool {
+	return strings.HasPrefix(earliest, "rt") || strings.HasPrefix(latest, "rt")
+}
+
+// suppressNonRealtimeRtSpanDiff ignores dispatch_rt_maximum_span changes for
+// non-real-time searches, where Splunk never applies the setting.
+func suppressNonRealtimeRtSpanDiff(k, old, new string, d *schema.ResourceData) bool {
+	return !isRealtimeSearch(d.Get("dispatch_earliest_time").(string), d.Get("dispatch_latest_time").(string))
+}
+
+// coerceBoolLikeInt maps the accepted spellings of Splunk's 0/1 integer flags
+// to their canonical integer form. The empty string counts as 0 so unset
+// attributes keep their previous zero-value behavior.
+func coerceBoolLikeInt(value string) (int, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "0", "false":
+		return 0, true
+	case "1", "true":
+		return 1, true
+	}
+	return 0, false
+}
+
+func validateBoolLikeInt(v interface{}, k string) (warnings []string, errors []error) {
+	if _, ok := coerceBoolLikeInt(v.(string)); !ok {
+		errors = append(errors, fmt.Errorf("%s: expected 0, 1, true or false, got %q", k, v))
+	}
+	return
+}
+
+func normalizeBoolLikeInt(v interface{}) string {
+	coerced, ok := coerceBoolLikeInt(fmt.Sprint(v))
+	if !ok {
+		return fmt.Sprint(v)
+	}
+	return strconv.Itoa(coerced)
+}
+
+// boolLikeIntSchema returns the schema for a Splunk 0/1 integer flag that also
+// accepts true/false in configuration. Values are normalized to "1"/"0" in
+// state and the server's integer form reads back unchanged.
+func boolLikeIntSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validateBoolLikeInt,
+		StateFunc:    normalizeBoolLikeInt,
+		Description:  description,
+	}
+}
+
+// getBoolLikeInt reads a bool-like flag attribute back as its integer form.
+func getBoolLikeInt(d *schema.ResourceData, key string) int {
+	coerced, _ := coerceBoolLikeInt(d.Get(key).(string))
+	return coerced
+}
+
+// suppressEmptyEquals treats an empty value returned by the server as equal to
+// the schema default in either direction. Splunk omits action parameters
+// entirely when the corresponding action app is not installed, which would
+// otherwise produce a perpetual diff back to the default.
+func suppressEmptyEquals(defaultValue string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return (old == "" && new == defaultValue) || (old == defaultValue && new == "")
+	}
+}
+
+// normalizeDelimitedString splits a comma- or semicolon-delimited list, trims
+// each element and sorts, yielding a canonical form for order-insensitive
+// comparison.
+func normalizeDelimitedString(value string) string {
+	items := strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' })
+	normalized := make([]string, 0, len(items))
+	for _, item := range items {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			normalized = append(normalized, trimmed)
+		}
+	}
+	sort.Strings(normalized)
+	return strings.Join(normalized, ",")
+}
+
+// expandEmailGroups resolves group names through the provider-level
+// email_groups map into a deduplicated, sorted recipient list. Groups missing
+// from the map are an error.
+func expandEmailGroups(groups []string, groupMap map[string]string) (string, error) {
+	seen := map[string]bool{}
+	recipients := []string{}
+	for _, group := range groups {
+		members, ok := groupMap[group]
+		if !ok {
+			return "", fmt.Errorf("email group %q is not defined in the provider email_groups map", group)
+		}
+		for _, member := range strings.FieldsFunc(members, func(r rune) bool { return r == ',' || r == ';' }) {
+			if trimmed := strings.TrimSpace(member); trimmed != "" && !seen[trimmed] {
+				seen[trimmed] = true
+				recipients = append(recipients, trimmed)
+			}
+		}
+	}
+	sort.Strings(recipients)
+	return strings.Join(recipients, ","), nil
+}
+
+// applyEmailGroups overwrites the recipient list on the config object with the
+// expansion of the configured groups, if any.
+func applyEmailGroups(d *schema.ResourceData, provider *SplunkProvider, savedSearchesObj *models.SavedSearchObject) error {
+	groups, ok := d.GetOk("action_email_to_groups")
+	if !ok {
+		return nil
+	}
+	names := []string{}
+	for _, group := range groups.([]interface{}) {
+		names = append(names, group.(string))
+	}
+	expanded, err := expandEmailGroups(names, provider.EmailGroups)
+	if err != nil {
+		return err
+	}
+	savedSearchesObj.ActionEmailTo = expanded
+	return nil
+}
+
+// checkSearchParses runs the search string through the parser endpoint and
+// errors when the SPL does not parse, catching unbalanced quotes and unknown
+// commands before an apply. An unreachable or missing parser endpoint skips
+// the check with a warning rather than failing the plan.
+func checkSearchParses(provider *SplunkProvider, search string) error {
+	// The client surfaces non-200 responses as errors with the drained body
+	// in the message, so a nil response is the only true "unreachable".
+	resp, err := (*provider.Client).ParseSearch(search)
+	if resp == nil {
+		log.Printf("[WARN] Skipping search syntax validation, parser endpoint unreachable: %s", err)
+		return nil
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 200, 201:
+		return nil
+
+	case 400:
+		return fmt.Errorf("search does not parse: %s", parseSearchErrorText(err))
+
+	default:
+		log.Printf("[WARN] Skipping search syntax validation, parser endpoint returned status %d", resp.StatusCode)
+		return nil
+	}
+}
+
+// parseSearchErrorText pulls the parser's message out of the client error,
+// whose text embeds the JSON error body.
+func parseSearchErrorText(err error) string {
+	if err == nil {
+		return ""
+	}
+	msg := err.Error()
+	if idx := strings.Index(msg, "{"); idx >= 0 {
+		response := &models.ParseSearchResponse{}
+		if json.Unmarshal([]byte(msg[idx:]), response) == nil && len(response.Messages) > 0 {
+			return response.Messages[0].Text
+		}
+	}
+	return msg
+}
+
+// validateWorkloadPool checks a configured workload_pool against the pools
+// the server knows. Instances without workload management do not serve the
+// endpoint, so a fetch failure skips validation with a warning rather than
+// failing the plan.
+func validateWorkloadPool(d *schema.ResourceDiff, provider *SplunkProvider) error {
+	pool, ok := d.GetOk("workload_pool")
+	if !ok {
+		return nil
+	}
+
+	pools, err := provider.validWorkloadPools()
+	if err != nil {
+		log.Printf("[WARN] Skipping workload_pool validation, unable to list workload pools: %s", err)
+		return nil
+	}
+	if !pools[pool.(string)] {
+		return fmt.Errorf("workload_pool %q does not exist on the server", pool)
+	}
+	return nil
+}
+
+// summarizeActions renders a concise description of the enabled actions and
+// their most identifying parameter, so terraform show gives an overview
+// without wading through dozens of action.* attributes.
+func summarizeActions(content *models.SavedSearchObject) string {
+	summaries := []string{}
+	for _, action := range strings.Split(normalizeActionsString(content.Actions), ",") {
+		if action == "" {
+			continue
+		}
+
+		key, value := "", ""
+		switch action {
+		case "email":
+			key, value = "to", content.ActionEmailTo
+		case "slack":
+			key, value = "channel", content.ActionSlackParamChannel
+		case "webhook":
+			key, value = "url", content.ActionWebhookParamUrl
+		case "script":
+			key, value = "filename", content.ActionScriptFilename
+		}
+
+		if value != "" {
+			summaries = append(summaries, fmt.Sprintf("%s(%s=%s)", action, key, value))
+		} else {
+			summaries = append(summaries, action)
+		}
+	}
+	return strings.Join(summaries, ", ")
+}
+
+// joinLookupRecipients joins the non-empty values of a lookup column into a
+// deduplicated, sorted recipient list.
+func joinLookupRecipients(results []map[string]interface{}, column string) string {
+	seen := map[string]bool{}
+	recipients := []string{}
+	for _, row := range results {
+		value, ok := row[column].(string)
+		if !ok {
+			continue
+		}
+		if trimmed := strings.TrimSpace(value); trimmed != "" && !seen[trimmed] {
+			seen[trimmed] = true
+			recipients = append(recipients, trimmed)
+		}
+	}
+	sort.Strings(recipients)
+	return strings.Join(recipients, ",")
+}
+
+// applyEmailLookup overwrites the recipient list on the config object with the
+// contents of the configured lookup column, if any.
+func applyEmailLookup(d *schema.ResourceData, provider *SplunkProvider, savedSearchesObj *models.SavedSearchObject) error {
+	block, ok := d.GetOk("action_email_to_lookup")
+	if !ok {
+		return nil
+	}
+	lookup := block.([]interface{})[0].(map[string]interface{})
+	aclObject := getResourceDataSearchACL(d)
+
+	recipients, err := provider.emailLookupRecipients(aclObject.Owner, aclObject.App, lookup["name"].(string), lookup["column"].(string))
+	if err != nil {
+		return err
+	}
+	savedSearchesObj.ActionEmailTo = recipients
+	return nil
+}
+
+// suppressDelimitedOrderDiff ignores ordering, whitespace and delimiter
+// differences between recipient lists so Splunk's canonicalization of
+// action.email.to and friends does not produce spurious diffs.
+func suppressDelimitedOrderDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeDelimitedString(old) == normalizeDelimitedString(new)
+}
+
+// suppressDefaultWorkloadPoolDiff treats an unset workload_pool as equal to
+// the server's default pool. Clearing the pool reverts the search to the
+// default, but Splunk then reports the default pool's name, which would
+// otherwise diff against the empty config forever.
+func suppressDefaultWorkloadPoolDiff(k, old, new string, d *schema.ResourceData) bool {
+	defaultPool := d.Get("default_workload_pool").(string)
+	return new == "" && defaultPool != "" && old == defaultPool
+}
+
 func normalizeActionsString(actions string) string {
 	if actions == "" {
 		return ""
@@ -48,6 +360,68 @@ func suppressActionsDiff(k, old, new string, d *schema.ResourceData) bool {
 	return normalizeActionsString(old) == normalizeActionsString(new)
 }
 
+// syncWebhookAction keeps the webhook entry in the actions list in step with
+// the webhook URL: a configured URL enables the action, a cleared URL removes
+// it, so the webhook never silently stays dark or lingers after removal.
+func syncWebhookAction(actions, webhookURL string) string {
+	actionList := strings.Split(normalizeActionsString(actions), ",")
+	var synced []string
+	hasWebhook := false
+	for _, action := range actionList {
+		if action == "webhook" {
+			hasWebhook = true
+			if webhookURL == "" {
+				continue
+			}
+		}
+		if action != "" {
+			synced = append(synced, action)
+		}
+	}
+	if webhookURL != "" && !hasWebhook {
+		synced = append(synced, "webhook")
+	}
+	sort.Strings(synced)
+	return strings.Join(synced, ",")
+}
+
+// validateSnowKeyValueFormat validates the ||-separated key=value format used
+// by the ServiceNow fields, e.g. k1=v1||k2=v2. A malformed value would
+// otherwise fail silently at alert time.
+func validateSnowKeyValueFormat(v interface{}, k string) ([]string, []error) {
+	value := v.(string)
+	if value == "" {
+		return nil, nil
+	}
+	for _, pair := range strings.Split(value, "||") {
+		key, _, found := strings.Cut(pair, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return nil, []error{fmt.Errorf("%s must be || separated key=value pairs (for example, k1=v1||k2=v2), got: %s", k, value)}
+		}
+	}
+	return nil, nil
+}
+
+// validateRiskObjectsJSON checks that the risk objects attribute holds a JSON
+// array of objects, the shape action.risk.param._risk expects.
+func validateRiskObjectsJSON(v interface{}, k string) ([]string, []error) {
+	value := v.(string)
+	if value == "" {
+		return nil, nil
+	}
+	var objects []map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &objects); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a JSON array of risk objects: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// validateLocale validates a BCP-47 language tag, e.g. en, en-US, zh-Hant.
+var validateLocale = validation.StringMatch(
+	regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`),
+	"Locale must be a BCP-47 language tag",
+)
+
 // calculateWebhookPriority calculates priority based on severity and precision
 // following the business logic from the Python exporter
 func calculateWebhookPriority(severity, precision string) int {
@@ -82,18 +456,51 @@ func calculateWebhookPriority(severity, precision string) int {
 	return 1
 }
 
+// webhookPriorityNames maps named webhook priorities to their numeric form.
+var webhookPriorityNames = map[string]int{
+	"low":      1,
+	"medium":   2,
+	"high":     3,
+	"critical": 4,
+}
+
+// webhookPriorityName maps a numeric webhook priority back to its named form,
+// returning the empty string for values outside the known range.
+func webhookPriorityName(priority int) string {
+	for name, value := range webhookPriorityNames {
+		if value == priority {
+			return name
+		}
+	}
+	return ""
+}
+
 // getCalculatedPriority returns either the manually set priority or auto-calculated one
 func getCalculatedPriority(d *schema.ResourceData) int {
-	// If priority is explicitly set, use it
-	if priority, ok := d.GetOk("action_webhook_param_priority"); ok {
+	// If priority is explicitly set, use it. GetOkExists rather than GetOk so
+	// a deliberately configured priority of 0 is honored; leaving the
+	// attribute unset means auto-calculate.
+	if priority, ok := d.GetOkExists("action_webhook_param_priority"); ok {
 		return priority.(int)
 	}
 
+	// A named priority maps directly onto its numeric form
+	if name, ok := d.GetOk("webhook_priority_name"); ok {
+		if priority, ok := webhookPriorityNames[name.(string)]; ok {
+			return priority
+		}
+	}
+
 	// Otherwise, calculate from severity and precision
 	severity := d.Get("severity").(string)
 	precision := d.Get("precision").(string)
 
 	if severity != "" && precision != "" {
+		if overrides, ok := d.GetOk("action_webhook_priority_map"); ok {
+			if priority, found := lookupPriorityOverride(overrides.(map[string]interface{}), severity, precision); found {
+				return priority
+			}
+		}
 		return calculateWebhookPriority(severity, precision)
 	}
 
@@ -101,6 +508,42 @@ func getCalculatedPriority(d *schema.ResourceData) int {
 	return 1
 }
 
+// requireSeverityPrecisionPair rejects configs setting exactly one of
+// severity and precision: auto-calculated webhook priority needs both and
+// would otherwise silently fall back to 1.
+func requireSeverityPrecisionPair(severity, precision string) error {
+	if (severity == "") == (precision == "") {
+		return nil
+	}
+	if severity == "" {
+		return fmt.Errorf("precision is set but severity is not; webhook priority auto-calculation needs both, set severity or remove precision")
+	}
+	return fmt.Errorf("severity is set but precision is not; webhook priority auto-calculation needs both, set precision or remove severity")
+}
+
+// lookupPriorityOverride resolves a "severity:precision" key from the
+// user-supplied priority map. Malformed keys and non-numeric values are
+// ignored with a warning so one typo does not disable the whole map.
+func lookupPriorityOverride(overrides map[string]interface{}, severity, precision string) (int, bool) {
+	for key, value := range overrides {
+		parts := strings.Split(key, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			log.Printf("[WARN] Ignoring malformed action_webhook_priority_map key %q, expected \"severity:precision\"", key)
+			continue
+		}
+		if parts[0] != severity || parts[1] != precision {
+			continue
+		}
+		priority, err := strconv.Atoi(fmt.Sprint(value))
+		if err != nil {
+			log.Printf("[WARN] Ignoring non-numeric action_webhook_priority_map value %q for key %q", fmt.Sprint(value), key)
+			continue
+		}
+		return priority, true
+	}
+	return 0, false
+}
+
 func savedSearches() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -111,6 +554,34 @@ func savedSearches() *schema.Resource {
 				Description:      "A comma-separated list of actions to enable. For example: rss,email ",
 				DiffSuppressFunc: suppressActionsDiff,
 			},
+			"action_summary": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "One-line summary of the enabled actions and their most identifying parameter, " +
+					"for example email(to=ops@example.com), slack(channel=#alerts). Read-only attribute for plan readability.",
+			},
+			"actions_raw": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The actions list exactly as Splunk stores it, without the sorting and trimming " +
+					"applied to actions. Read-only; useful for debugging when the two diverge.",
+			},
+			"replace_action_params": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, updates that remove an action from `actions` also clear that " +
+					"action's `action.<name>.param.*` values on the server instead of leaving them behind.",
+			},
+			"server_default_action_params": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Overrides for the built-in table of action parameter defaults Splunk fills in " +
+					"when an action is enabled, keyed by attribute name (e.g. action_email_format = \"table\"). " +
+					"Drift on a listed attribute is suppressed when the configuration leaves it unset and the " +
+					"server reports the given default.",
+			},
 			"action_snow_event_param_account": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -153,15 +624,17 @@ func savedSearches() *schema.Resource {
 				Description: "	A brief description of the event.",
 			},
 			"action_snow_event_param_ci_identifier": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "String that represents a configuration item in your network. You can pass value as || separated key-value format. For example, k1=v1||k2=v2.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "String that represents a configuration item in your network. You can pass value as || separated key-value format. For example, k1=v1||k2=v2.",
+				ValidateFunc: validateSnowKeyValueFormat,
 			},
 			"action_snow_event_param_custom_fields": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSnowKeyValueFormat,
 				Description: "The custom fields which are configured at the ServiceNow Instance. " +
 					"You can pass the custom fields and their values in the || separated format. For example, custom_field1=value1||custom_field2=value2||..." +
 					"custom_fields used must be present in the em_event table of ServiceNow.",
@@ -177,6 +650,13 @@ func savedSearches() *schema.Resource {
 					"Note that if you create events using the commands snowevent or snoweventstream, you must supply the URL in this field." +
 					"You can pass the URL of Splunk as url=<value>. You can also pass other fields and their values by || separated key-value format. For example, url=<value>||k1=v1||k2=v2||....",
 			},
+			"action_snow_event_drilldown_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Deep-link URL back to the Splunk platform search, merged into " +
+					"action.snow_event.param.additional_info as url=<value>|| while preserving any other key-values. " +
+					"Avoids assembling the url= entry by hand.",
+			},
 			"action_email": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -184,9 +664,11 @@ func savedSearches() *schema.Resource {
 					"Value ignored on POST. Use actions to specify a list of enabled actions. Defaults to 0.",
 			},
 			"action_email_auth_password": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressMaskedPasswordDiff,
 				Description: "The password to use when authenticating with the SMTP server. " +
 					"Normally this value is set when editing the email settings, however you can set a clear text password here and it is encrypted on the next platform restart." +
 					"Defaults to empty string.",
@@ -199,17 +681,29 @@ func savedSearches() *schema.Resource {
 					"If this is empty string, no authentication is attempted. Defaults to empty string" +
 					"NOTE: Your SMTP server might reject unauthenticated emails.",
 			},
+			"action_email_auth_mechanism": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"plain", "login", "cram-md5"}, false),
+				Description: "Valid values: (plain | login | cram-md5). The SASL mechanism to use when " +
+					"authenticating with the SMTP server. Defaults to the server's preference.",
+			},
 			"action_email_bcc": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "BCC email address to use if action.email is enabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateEmailList,
+				DiffSuppressFunc: suppressDelimitedOrderDiff,
+				Description:      "BCC email address to use if action.email is enabled.",
 			},
 			"action_email_cc": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "CC email address to use if action.email is enabled.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateEmailList,
+				DiffSuppressFunc: suppressDelimitedOrderDiff,
+				Description:      "CC email address to use if action.email is enabled.",
 			},
 			"action_email_command": {
 				Type:     schema.TypeString,
@@ -220,9 +714,10 @@ func savedSearches() *schema.Resource {
 					"To reference saved search field values wrap them in $, for example to reference the savedsearch name use $name$, to reference the search use $search$. ",
 			},
 			"action_email_format": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressServerAddedDefault,
 				Description: "Valid values: (table | plain | html | raw | csv)" +
 					"Specify the format of text in the email. This value also applies to any attachments.",
 			},
@@ -233,6 +728,13 @@ func savedSearches() *schema.Resource {
 				Description: "Email address from which the email action originates." +
 					"Defaults to splunk@$LOCALHOST or whatever value is set in alert_actions.conf.",
 			},
+			"action_email_graceful": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				Description: "Indicates whether a failure to send email should not affect the other actions of the " +
+					"saved search. Defaults to false.",
+			},
 			"action_email_hostname": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -240,36 +742,11 @@ func savedSearches() *schema.Resource {
 				Description: "Sets the hostname used in the web link (url) sent in email actions." +
 					"This value accepts two forms:hostname (for example, splunkserver, splunkserver.example.com) ",
 			},
-			"action_email_include_results_link": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specify whether to include a link to the results. [1|0]",
-			},
-			"action_email_include_search": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specify whether to include the search that caused an email to be sent. [1|0]",
-			},
-			"action_email_include_trigger": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specify whether to show the trigger condition that caused the alert to fire. [1|0]",
-			},
-			"action_email_include_trigger_time": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specify whether to show the time that the alert was fired. [1|0]",
-			},
-			"action_email_include_view_link": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Specify whether to show the title and a link to enable the user to edit the saved search. [1|0]",
-			},
+			"action_email_include_results_link": boolLikeIntSchema("Specify whether to include a link to the results. [1|0|true|false]"),
+			"action_email_include_search":       boolLikeIntSchema("Specify whether to include the search that caused an email to be sent. [1|0|true|false]"),
+			"action_email_include_trigger":      boolLikeIntSchema("Specify whether to show the trigger condition that caused the alert to fire. [1|0|true|false]"),
+			"action_email_include_trigger_time": boolLikeIntSchema("Specify whether to show the time that the alert was fired. [1|0|true|false]"),
+			"action_email_include_view_link":    boolLikeIntSchema("Specify whether to show the title and a link to enable the user to edit the saved search. [1|0|true|false]"),
 			"action_email_inline": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -277,6 +754,13 @@ func savedSearches() *schema.Resource {
 				Description: "Indicates whether the search results are contained in the body of the email." +
 					"Results can be either inline or attached to an email. ",
 			},
+			"action_email_locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Locale hint (BCP-47 language tag, for example en-US or ja) used when rendering internationalized alert emails.",
+				ValidateFunc: validateLocale,
+			},
 			"action_email_mailserver": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -311,10 +795,11 @@ func savedSearches() *schema.Resource {
 				Description: "Message sent in the emailed report. Defaults to: The scheduled report '$name$' has run.",
 			},
 			"action_email_pdfview": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The name of the view to deliver if sendpdf is enabled",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"pdf_delivery"},
+				Description:   "The name of the view to deliver if sendpdf is enabled",
 			},
 			"action_email_preprocess_results": {
 				Type:     schema.TypeString,
@@ -331,23 +816,36 @@ func savedSearches() *schema.Resource {
 					"If multiple fonts provide a glyph for a given character code, the glyph from the first font specified in the list is used." +
 					"To skip loading any CID fonts, specify the empty string.Defaults to 'gb cns jp kor'",
 			},
-			"action_email_report_include_splunk_logo": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Computed:    true,
-				Description: "Indicates whether to include the Splunk logo with the report.",
-			},
-			"action_email_report_paper_orientation": {
+			"action_email_report_file_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				Description: "Name of the file attached to the email when results are sent as an attachment. " +
+					"Also applies to CSV attachments sent with action.email.format=csv or action.email.sendcsv. " +
+					"Defaults to $name$-$time:%Y-%m-%d$.",
+			},
+			"action_email_report_include_splunk_logo": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"pdf_delivery"},
+				Description:   "Indicates whether to include the Splunk logo with the report.",
+			},
+			"action_email_report_paper_orientation": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"pdf_delivery"},
+				DiffSuppressFunc: suppressServerAddedDefault,
 				Description: "Valid values: (portrait | landscape)" +
 					"Specifies the paper orientation: portrait or landscape. Defaults to portrait.",
 			},
 			"action_email_report_paper_size": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"pdf_delivery"},
+				DiffSuppressFunc: suppressServerAddedDefault,
 				Description: "Valid values: (letter | legal | ledger | a2 | a3 | a4 | a5)" +
 					"Specifies the paper size for PDFs. Defaults to letter.",
 			},
@@ -371,10 +869,54 @@ func savedSearches() *schema.Resource {
 				Description: "Specify whether to send results as a CSV file. Default: 0 (false).",
 			},
 			"action_email_send_pdf": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Computed:    true,
-				Description: "Indicates whether to create and send the results as a PDF. Defaults to false.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"pdf_delivery"},
+				Description:   "Indicates whether to create and send the results as a PDF. Defaults to false.",
+			},
+			"pdf_delivery": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "Groups the email PDF settings in one block instead of the flat " +
+					"action_email_send_pdf/action_email_pdfview/action_email_report_* fields. " +
+					"Conflicts with those flat fields.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether to create and send the results as a PDF.",
+						},
+						"view": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the view to render as the PDF.",
+						},
+						"paper_size": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "letter",
+							ValidateFunc: validation.StringInSlice([]string{"letter", "legal", "ledger", "a2", "a3", "a4", "a5"}, false),
+							Description:  "Paper size for the PDF. Defaults to letter.",
+						},
+						"orientation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "portrait",
+							ValidateFunc: validation.StringInSlice([]string{"portrait", "landscape"}, false),
+							Description:  "Paper orientation for the PDF. Defaults to portrait.",
+						},
+						"include_logo": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to include the Splunk logo in the PDF.",
+						},
+					},
+				},
 			},
 			"action_email_send_results": {
 				Type:     schema.TypeBool,
@@ -383,6 +925,14 @@ func savedSearches() *schema.Resource {
 				Description: "Indicates whether to attach the search results in the email." +
 					"Results can be either attached or inline. See action.email.inline.",
 			},
+			"email_attachment_warning_threshold": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
+				Description: "Threshold for action_email_max_results above which a warning is logged during planning " +
+					"when action_email_send_results is enabled, since oversized attachments often fail delivery. " +
+					"Set to 0 to disable the warning.",
+			},
 			"action_email_allow_empty_attachment": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -396,12 +946,45 @@ func savedSearches() *schema.Resource {
 				Description: "Specifies an alternate email subject.Defaults to SplunkAlert-<savedsearchname>.",
 			},
 			"action_email_to": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateEmailList,
+				DiffSuppressFunc: suppressDelimitedOrderDiff,
 				Description: "A comma or semicolon separated list of recipient email addresses. " +
 					"Required if this search is scheduled and the email alert action is enabled.",
 			},
+			"action_email_to_groups": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"action_email_to"},
+				Description: "Named recipient groups from the provider-level email_groups map, expanded " +
+					"provider-side into action_email_to. Conflicts with action_email_to.",
+			},
+			"action_email_to_lookup": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"action_email_to", "action_email_to_groups"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the CSV lookup holding the distribution list.",
+						},
+						"column": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "email",
+							Description: "Lookup column holding the recipient addresses. Defaults to email.",
+						},
+					},
+				},
+				Description: "CSV lookup to read the recipient list from at apply time. The provider joins " +
+					"the column's values into action_email_to. Conflicts with action_email_to and action_email_to_groups.",
+			},
 			"action_email_track_alert": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -416,6 +999,12 @@ func savedSearches() *schema.Resource {
 					"If p follows <Integer>, int is the number of scheduled periods. Defaults to 86400 (24 hours)." +
 					"If no actions are triggered, the artifacts have their ttl determined by dispatch.ttl in savedsearches.conf.",
 			},
+			"action_email_use_ns_subject": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether to use the namespaced subject (action.email.useNSSubject) instead of action.email.subject. Defaults to false.",
+			},
 			"action_email_use_ssl": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -437,10 +1026,20 @@ func savedSearches() *schema.Resource {
 					"Only valid if format=text.",
 			},
 			"action_pagerduty_custom_details": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The PagerDuty custom details information.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"action_pagerduty_custom_details_map"},
+				Description:   "The PagerDuty custom details information.",
+			},
+			"action_pagerduty_custom_details_map": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"action_pagerduty_custom_details"},
+				Description: "The PagerDuty custom details information as a map of key-value pairs, " +
+					"serialized to JSON for the PagerDuty Events API v2. Conflicts with action_pagerduty_custom_details.",
 			},
 			"action_pagerduty_integration_key": {
 				Type:     schema.TypeString,
@@ -465,6 +1064,31 @@ func savedSearches() *schema.Resource {
 				Optional:    true,
 				Description: "The pagerduty integration URL override. This integration uses Splunk's native webhooks to send events to PagerDuty.",
 			},
+			"action_risk": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "The state of the risk analysis action used for Enterprise Security risk-based alerting. Automatically determined from actions field.",
+			},
+			"action_risk_param_risk": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateRiskObjectsJSON,
+				Description: "JSON array of risk objects attributed when the search matches, each with risk_object_field, " +
+					"risk_object_type and risk_score keys. Stored as action.risk.param._risk. Only sent when risk is in actions.",
+			},
+			"action_risk_param_risk_score": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default risk score applied to the risk objects. Only sent when risk is in actions.",
+			},
+			"action_risk_param_risk_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Message attached to the risk events, supports field substitution. Only sent when risk is in actions.",
+			},
 			"action_script": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -534,10 +1158,11 @@ func savedSearches() *schema.Resource {
 					"Comma-separated list of field names. Allows wildcards. eg. index,source*",
 			},
 			"action_slack_param_attachment": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "none",
-				Description: "Optionally include a message attachment. Valid values are message, alert_link, or none",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "none",
+				DiffSuppressFunc: suppressEmptyEquals("none"),
+				Description:      "Optionally include a message attachment. Valid values are message, alert_link, or none",
 			},
 			"action_slack_param_message": {
 				Type:        schema.TypeString,
@@ -564,6 +1189,15 @@ func savedSearches() *schema.Resource {
 				Optional:    true,
 				Description: "Jira Issue Type you would like to create",
 			},
+			"validate_jira_issue_type": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, validates action_jira_service_desk_param_jira_issue_type against the " +
+					"project metadata cached by the JIRA Service Desk add-on before creating or updating the search, " +
+					"logging a warning when the issue type is not valid for the project. " +
+					"Requires the add-on's REST handler to be installed on the Splunk instance.",
+			},
 			"action_jira_service_desk_param_jira_summary": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -586,12 +1220,134 @@ func savedSearches() *schema.Resource {
 				Optional:    true,
 				Description: "Enter custom fields data for the issue created",
 			},
+			"action_discord": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "The state of the discord action. Automatically determined from actions field.",
+			},
+			"action_discord_param_webhook_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Discord webhook URL the alert message is posted to.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^https?://[^\s]+$`), "Discord webhook URL is invalid"),
+			},
+			"action_discord_param_username": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressServerAddedDefault,
+				Description:      "Username the alert message is posted as in the Discord channel.",
+			},
+			"action_discord_param_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Message posted to the Discord channel when the alert triggers.",
+			},
+			"action_keyindicator": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "The state of the keyindicator action. Automatically determined from actions field.",
+			},
+			"action_keyindicator_param_title": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Title shown on the Enterprise Security key indicator panel.",
+			},
+			"action_keyindicator_param_subtitle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Subtitle shown below the key indicator value.",
+			},
+			"action_keyindicator_param_value_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Suffix appended to the key indicator value, for example a unit.",
+			},
+			"action_keyindicator_param_invert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Set to 1 to treat a decreasing value as good and an increasing one as bad.",
+			},
+			"action_keyindicator_param_threshold": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Threshold at which the key indicator changes severity.",
+			},
+			"action_keyindicator_param_drilldown_uri": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URI of the view to open when the key indicator is clicked.",
+			},
+			"action_notable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "The state of the notable event action used by Enterprise Security correlation searches. Automatically determined from actions field.",
+			},
+			"action_notable_param_rule_title": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Title of the notable event created when the correlation search matches. Supports field substitution (e.g. $src$).",
+			},
+			"action_notable_param_rule_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Description of the notable event created when the correlation search matches.",
+			},
+			"action_notable_param_security_domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Security domain the notable event belongs to (access, endpoint, network, threat, identity, audit).",
+				ValidateFunc: validation.StringInSlice([]string{"access", "endpoint", "network", "threat", "identity", "audit"}, false),
+			},
+			"action_notable_param_severity": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Severity assigned to the notable event (e.g. informational, low, medium, high, critical).",
+			},
+			"action_notable_param_nes_fields": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Comma-separated list of fields used for notable event suppression.",
+			},
+			"action_telegram": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "The state of the telegram action. Automatically determined from actions field.",
+			},
+			"action_telegram_param_bot_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Token of the Telegram bot used to send the alert message.",
+			},
+			"action_telegram_param_chat_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Identifier of the Telegram chat the alert message is sent to.",
+			},
+			"action_telegram_param_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Message sent by the Telegram bot when the alert triggers.",
+			},
 			"action_webhook_param_url": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Description:  "URL to send the HTTP POST request to. Must be accessible from the Splunk server.",
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^https?://[^\s]+$`), "Webhook URL is invalid"),
 			},
+			"validate_webhook_url_reachability": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, performs a HEAD request against action_webhook_param_url before creating or " +
+					"updating the search and logs a warning when the URL is unreachable. The check runs from the host " +
+					"running Terraform, which may have different network access than the Splunk server.",
+			},
 			"action_webhook": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -607,7 +1363,29 @@ func savedSearches() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Computed:    true,
-				Description: "Priority parameter for webhook action. If not set, will be auto-calculated from severity and precision.",
+				Description: "Priority parameter for webhook action. Leave unset to auto-calculate from severity and precision; an explicit value, including 0, is sent as-is.",
+			},
+			"action_webhook_param_http_headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				Description: "Custom HTTP headers sent with the webhook request, keyed by header name. Each entry " +
+					"is stored as action.webhook.param.http_header.<name>. Marked sensitive since headers often carry tokens.",
+			},
+			"action_webhook_priority_map": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Overrides for the built-in severity/precision priority matrix, keyed by \"severity:precision\" (e.g. \"Critical:High\" = \"5\"). Combinations missing from the map fall back to the built-in matrix.",
+			},
+			"webhook_priority_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Named form of the webhook priority (critical, high, medium, low). Conflicts with action_webhook_param_priority; the numeric priority is derived from the name.",
+				ValidateFunc:  validation.StringInSlice([]string{"critical", "high", "medium", "low"}, false),
+				ConflictsWith: []string{"action_webhook_param_priority"},
 			},
 			"severity": {
 				Type:         schema.TypeString,
@@ -661,9 +1439,10 @@ func savedSearches() *schema.Resource {
 					"Defaults to 1 (true).",
 			},
 			"alert_expires": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressDefault(defaultAlertExpires),
 				Description: "Valid values: [number][time-unit]Sets the period of time to show the alert in the dashboard. Defaults to 24h." +
 					"Use [number][time-unit] to specify a time. " +
 					"For example: 60 = 60 seconds, 1m = 1 minute, 1h = 60 minutes = 1 hour.",
@@ -688,10 +1467,23 @@ func savedSearches() *schema.Resource {
 				Description: "Comma delimited list of fields to use for suppression when doing per result alerting. " +
 					"Required if suppression is turned on and per result alerting is enabled.",
 			},
-			"alert_suppress_period": {
+			"alert_suppress_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				Description: "Name of the suppression group used when doing per result alerting. " +
+					"Only meaningful when alert_suppress is enabled and alert_suppress_fields is set.",
+			},
+			"suppression_remaining": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of seconds the alert's suppression has left. Read-only attribute. Zero when the search is not currently suppressed.",
+			},
+			"alert_suppress_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTimeSpecifier,
 				Description: "Valid values: [number][time-unit] Specifies the suppresion period. Only valid if alert.supress is enabled." +
 					"Use [number][time-unit] to specify a time. For example: 60 = 60 seconds, 1m = 1 minute, 1h = 60 minutes = 1 hour. ",
 			},
@@ -703,10 +1495,54 @@ func savedSearches() *schema.Resource {
 					"auto - determine whether to track or not based on the tracking setting of each action, do not track scheduled searches that always trigger actions. " +
 					"Default value true - force alert tracking.false - disable alert tracking for this search.",
 			},
+			"allowed_indexes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Allowlist of indexes the search may reference. When set, index= clauses in the " +
+					"search are checked at plan time and any index outside the list fails the plan. Wildcard or " +
+					"macro-hidden index references only produce a warning, since they cannot be fully resolved.",
+			},
+			"base_search_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Name of a base saved search this search post-processes. When set, the configured " +
+					"search is wrapped as `| savedsearch \"<base>\" | <search>`. The base saved search must be " +
+					"created first; add a depends_on on its resource to order the applies.",
+			},
+			"blackout_windows": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Declarative maintenance windows during which triggered alerts are suppressed. " +
+					"Each window is translated into alert.suppress with an alert.suppress.period covering the window. " +
+					"start and end are clock times in HH:MM (24 hour) format. " +
+					"An explicitly configured alert_suppress_period takes precedence over the generated period.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"acceleration_eligible": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: "Indicates whether the search is eligible for report acceleration, " +
+					"derived from whether the search contains a transforming command.",
+			},
 			"alert_comparator": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"greater than", "less than", "equal to", "rises by", "drops by", "rises by perc", "drops by perc",
+				}, false),
 				Description: "One of the following strings: greater than, less than, equal to, rises by, drops by, rises by perc, drops by perc" +
 					"Used with alert_threshold to trigger alert actions.",
 			},
@@ -746,9 +1582,10 @@ func savedSearches() *schema.Resource {
 					"Defaults to 0.",
 			},
 			"auto_summarize_command": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAutoSummarizeCommand,
 				Description: "An auto summarization template for this search. " +
 					"See auto summarization options in savedsearches.conf for more details.",
 			},
@@ -774,10 +1611,11 @@ func savedSearches() *schema.Resource {
 					"If this value is an absolute time, use the dispatch.time_format to format the value.",
 			},
 			"auto_summarize_dispatch_time_format": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Defines the time format that Splunk software uses to specify the earliest and latest time. Defaults to %FT%T.%Q%:z",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStrptimeFormat,
+				Description:  "Defines the time format that Splunk software uses to specify the earliest and latest time. Defaults to %FT%T.%Q%:z",
 			},
 			"auto_summarize_dispatch_ttl": {
 				Type:     schema.TypeString,
@@ -831,13 +1669,44 @@ func savedSearches() *schema.Resource {
 					"For example a timechart over the last month whose granuality is at the day level should set this to 1d. If you need the same data summarized at the hour level for weekly charts, use: 1h,1d. ",
 			},
 			"cron_schedule": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressCronEquivalentDiff,
 				Description: "Valid values: cron string" +
 					"The cron schedule to execute this search. " +
 					"For example: */5 * * * * causes the search to execute every 5 minutes. ",
 			},
+			"dependencies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "Lookups, macros, data models, and saved searches referenced by the search string. " +
+					"Read-only attribute derived by parsing the SPL, intended for impact analysis.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lookups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"macros": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"data_models": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"saved_searches": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -857,24 +1726,58 @@ func savedSearches() *schema.Resource {
 				Computed:    true,
 				Description: "The maximum number of timeline buckets. Defaults to 0. ",
 			},
-			"dispatch_earliest_time": {
-				Type:     schema.TypeString,
-				Optional: true,
+			"dispatch_user_search_jobs_quota": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "Effective srchJobsQuota of the user dispatching this search, the maximum across their roles. " +
+					"Read-only attribute. Only populated for scheduled searches; helps diagnose throttled runs.",
+			},
+			"dispatch_user_active_search_jobs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "Number of search jobs currently running in the dispatch user's namespace. " +
+					"Read-only attribute. Only populated for scheduled searches.",
+			},
+			"effectively_scheduled": {
+				Type:     schema.TypeBool,
 				Computed: true,
+				Description: "Whether the search will actually run on a schedule: is_scheduled is set, the search " +
+					"is not disabled and a cron_schedule is configured.",
+			},
+			"embed_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Enable embedded reporting for this saved search. " +
+					"Requires the search to be scheduled and not disabled.",
+			},
+			"embed_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Token generated by Splunk when embedding is enabled, used to build the embed URL.",
+			},
+			"dispatch_earliest_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"time_range"},
 				Description: "A time string that specifies the earliest time for this search. Can be a relative or absolute time." +
 					"If this value is an absolute time, use the dispatch.time_format to format the value.",
 			},
 			"dispatch_index_earliest": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"time_range"},
 				Description: "A time string that specifies the latest time for this search. Can be a relative or absolute time." +
 					"If this value is an absolute time, use the dispatch.time_format to format the value.",
 			},
 			"dispatch_index_latest": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"time_range"},
 				Description: "A time string that specifies the earliest time for this search. Can be a relative or absolute time." +
 					"If this value is an absolute time, use the dispatch.time_format to format the value.",
 			},
@@ -898,9 +1801,10 @@ func savedSearches() *schema.Resource {
 				Description: "Allows for a per-job override of the [search] indexed_realtime_disk_sync_delay setting in limits.conf.",
 			},
 			"dispatch_latest_time": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"time_range"},
 				Description: "A time string that specifies the latest time for this saved search. Can be a relative or absolute time." +
 					"If this value is an absolute time, use the dispatch.time_format to format the value.",
 			},
@@ -937,10 +1841,13 @@ func savedSearches() *schema.Resource {
 					" Parameter valid only if this is a real time search. Defaults to 0.",
 			},
 			"dispatch_rt_maximum_span": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Allows for a per-job override of the [search] indexed_realtime_maximum_span setting in limits.conf.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IntAtLeast(0),
+				DiffSuppressFunc: suppressNonRealtimeRtSpanDiff,
+				Description: "Allows for a per-job override of the [search] indexed_realtime_maximum_span setting in limits.conf. " +
+					"Only applies to indexed real-time searches; ignored (and diff-suppressed) otherwise.",
 			},
 			"dispatch_spawn_process": {
 				Type:     schema.TypeBool,
@@ -950,9 +1857,10 @@ func savedSearches() *schema.Resource {
 					"Defaults to 1. Searches against indexes must run in a separate process. ",
 			},
 			"dispatch_time_format": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStrptimeFormat,
 				Description: "A time format string that defines the time format for specifying the earliest and latest time. " +
 					"Defaults to %FT%T.%Q%:z",
 			},
@@ -963,6 +1871,13 @@ func savedSearches() *schema.Resource {
 				Description: "Valid values: Integer[p]. Defaults to 2p." +
 					"Indicates the time to live (in seconds) for the artifacts of the scheduled search, if no actions are triggered. ",
 			},
+			"color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Color/theme metadata for the search, stored in the display.general.color key. " +
+					"Keeps searches surfaced in dashboards visually consistent.",
+			},
 			"display_view": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1033,15 +1948,22 @@ func savedSearches() *schema.Resource {
 					"Defaults to 0. Set to 1 for scheduled searches that populate lookup tables. ",
 			},
 			"schedule_window": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateScheduleWindow,
 				Description: "Time window (in minutes) during which the search has lower priority. Defaults to 0. " +
 					"The scheduler can give higher priority to more critical searches during this window. " +
 					"The window must be smaller than the search period." +
 					"Set to auto to let the scheduler determine the optimal window value automatically. " +
 					"Requires the edit_search_schedule_window capability to override auto. ",
 			},
+			"effective_schedule_window": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The concrete window, in minutes, the scheduler works with after resolving an auto " +
+					"schedule_window. Read-only attribute; equals schedule_window when a fixed value is configured.",
+			},
 			"schedule_priority": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -1059,13 +1981,119 @@ func savedSearches() *schema.Resource {
 				Computed:    true,
 				Description: "Defines the viewstate id associated with the UI view listed in 'displayview'. ",
 			},
+			"skipped_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "Number of scheduled executions the scheduler has skipped, for example due to " +
+					"concurrency limits. Read-only scheduler health indicator.",
+			},
+			"system_disabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: "Whether the search looks auto-disabled by the system rather than a user: it is " +
+					"disabled while its scheduling config is intact and runs were skipped. Read-only troubleshooting aid.",
+			},
+			"triggered_alert_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "Number of times this alert has triggered, from the saved search's fired-alerts " +
+					"summary. Read-only monitoring aid.",
+			},
+			"vsid_valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: "Indicates whether the configured vsid resolves to an existing viewstate object. " +
+					"A dangling vsid leaves stale drilldowns in the UI.",
+			},
 			"workload_pool": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressDefaultWorkloadPoolDiff,
+				Description: "Specifies the new workload pool where the existing running search will be placed. " +
+					"When cleared, the search reverts to the pool named by default_workload_pool and no diff is shown.",
+			},
+			"default_workload_pool": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Name of the instance's default workload pool. When workload_pool is not configured " +
+					"and the server reports this pool, the difference is suppressed instead of showing drift.",
+			},
+			"run_on_create": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, dispatches a one-off run of the saved search after it is created, " +
+					"for example to populate a lookup. A failed dispatch logs a warning but does not fail the create.",
+			},
+			"last_dispatch_sid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SID of the search job dispatched by run_on_create.",
+			},
+			"time_range": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"dispatch_earliest_time", "dispatch_latest_time", "dispatch_index_earliest", "dispatch_index_latest"},
+				Description: "Groups the dispatch time window settings in one block. " +
+					"Conflicts with the flat dispatch_earliest_time, dispatch_latest_time, " +
+					"dispatch_index_earliest and dispatch_index_latest fields.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"earliest": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"latest": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"index_earliest": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"index_latest": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"acl": savedSearchACLSchema(),
+			"current_app": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "App the search currently lives in, from eai:acl.app. Read-only; useful when relocating searches between apps.",
+			},
+			"acl_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Name of an existing saved search whose ACL (sharing, owner, permissions) is copied " +
+					"onto this search on create. An explicit acl block takes precedence.",
+			},
+			"detect_external_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, updates re-read the search's updated timestamp first and fail if it " +
+					"changed since the last read, catching concurrent writes from another workspace or the UI " +
+					"before they are silently overwritten.",
+			},
+			"last_updated": {
 				Type:        schema.TypeString,
-				Optional:    true,
 				Computed:    true,
-				Description: "Specifies the new workload pool where the existing running search will be placed.",
+				Description: "Timestamp of the last server-side modification, from the entry's updated field. Read-only.",
+			},
+			"owner_on_update": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "keep",
+				ValidateFunc: validation.StringInSlice([]string{"keep", "nobody", "owner"}, false),
+				Description: "Namespace owner used when updating the search. keep resolves it from sharing as " +
+					"before (nobody for shared objects), nobody always targets the nobody namespace, and owner " +
+					"always uses the configured owner so the search stays in their \"My Searches\".",
 			},
-			"acl": aclSchema(),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -1075,70 +2103,933 @@ func savedSearches() *schema.Resource {
 				Version: 0,
 			},
 		},
-		Create: savedSearchesCreate,
-		Read:   savedSearchesRead,
-		Update: savedSearchesUpdate,
-		Delete: savedSearchesDelete,
+		CustomizeDiff: savedSearchesCustomizeDiff,
+		Create:        savedSearchesCreate,
+		Read:          savedSearchesRead,
+		Update:        savedSearchesUpdate,
+		Delete:        savedSearchesDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: savedSearchesImport,
 		},
 	}
 
 }
 
-func resourceAlertTrackV0() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"alert_track": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				Description: "Valid values: (true | false | auto) Specifies whether to track the actions triggered by this scheduled search." +
-					"auto - determine whether to track or not based on the tracking setting of each action, do not track scheduled searches that always trigger actions. " +
-					"Default value true - force alert tracking.false - disable alert tracking for this search.",
-			},
-		},
+// savedSearchesImport accepts import IDs of the form app/owner/name in
+// addition to a bare name, seeding the acl block so the first read resolves
+// the search in the right namespace instead of defaulting to nobody/search.
+func savedSearchesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	app, owner, name, err := parseSavedSearchImportID(d.Id())
+	if err != nil {
+		return nil, err
 	}
-}
 
-func resourceAlertTrackStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	rawState["alert_track"], _ = strconv.ParseBool(rawState["alert_track"].(string))
-	return rawState, nil
+	d.SetId(name)
+	// A bare name carries no namespace, and defaulting to nobody/search 404s
+	// for searches living in another app, so discover the real namespace
+	// through the wildcard endpoint instead.
+	if app == "" {
+		app, owner, err = discoverSavedSearchNamespace(meta.(*SplunkProvider), name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = d.Set("acl", []interface{}{map[string]interface{}{
+		"app":   app,
+		"owner": owner,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// discoverSavedSearchNamespace resolves the app and owner of a saved search by
+// querying the servicesNS/-/- wildcard namespace. A name that resolves in more
+// than one app is ambiguous and must be imported as <app>/<owner>/<name>.
+func discoverSavedSearchNamespace(provider *SplunkProvider, name string) (app, owner string, err error) {
+	resp, err := (*provider.Client).ReadSavedSearches(name, "-", "-")
+	if err != nil {
+		return "", "", fmt.Errorf("unable to discover the namespace of saved search %q: %s", name, err)
+	}
+	defer resp.Body.Close()
+
+	response := &models.SavedSearchesResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return "", "", fmt.Errorf("unable to decode saved searches response: %w", err)
+	}
+
+	apps := []string{}
+	for _, entry := range response.Entry {
+		if entry.Name != name {
+			continue
+		}
+		app, owner = entry.ACL.App, entry.ACL.Owner
+		apps = append(apps, entry.ACL.App)
+	}
+	switch len(apps) {
+	case 0:
+		return "", "", fmt.Errorf("saved search %q was not found in any app", name)
+	case 1:
+		return app, owner, nil
+	default:
+		return "", "", fmt.Errorf("saved search %q exists in multiple apps (%s); import it as <app>/<owner>/%s",
+			name, strings.Join(apps, ", "), name)
+	}
+}
+
+// parseSavedSearchImportID splits an import ID into its app, owner and name
+// parts. A bare name returns empty app and owner, leaving the defaults.
+func parseSavedSearchImportID(id string) (app, owner, name string, err error) {
+	parts := strings.Split(id, "/")
+	switch len(parts) {
+	case 1:
+		name = parts[0]
+	case 3:
+		app, owner, name = parts[0], parts[1], parts[2]
+	default:
+		return "", "", "", fmt.Errorf("invalid import ID %q: expected <name> or <app>/<owner>/<name>", id)
+	}
+	if name == "" || (len(parts) == 3 && (app == "" || owner == "")) {
+		return "", "", "", fmt.Errorf("invalid import ID %q: expected <name> or <app>/<owner>/<name>", id)
+	}
+	return app, owner, name, nil
+}
+
+func savedSearchesCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	warnScheduleWindowExceedsPeriod(d)
+	warnLargeEmailAttachment(d)
+	if err := requireAutoSummarizeCommand(d); err != nil {
+		return err
+	}
+	if err := requireSuppressionForGroupName(d); err != nil {
+		return err
+	}
+	if err := requireComparatorThresholdPair(d.Get("alert_comparator").(string), d.Get("alert_threshold").(string)); err != nil {
+		return err
+	}
+	// Only relevant when the priority would be auto-calculated; an explicit
+	// priority or named priority makes severity/precision advisory.
+	if _, prioritySet := d.GetOkExists("action_webhook_param_priority"); !prioritySet {
+		if _, nameSet := d.GetOk("webhook_priority_name"); !nameSet {
+			if err := requireSeverityPrecisionPair(d.Get("severity").(string), d.Get("precision").(string)); err != nil {
+				return err
+			}
+		}
+	}
+	// is_scheduled is computed, so only an explicit false counts as opting out
+	// of scheduling.
+	isScheduled, isScheduledSet := d.GetOkExists("is_scheduled")
+	if err := validateSchedulingConsistency(isScheduledSet && isScheduled.(bool), isScheduledSet,
+		d.Get("cron_schedule").(string), d.Get("actions").(string)); err != nil {
+		return err
+	}
+	// alert_digest_mode defaults to true on the server, so only an explicit
+	// false means per-result alerting.
+	digestMode, digestModeSet := d.GetOkExists("alert_digest_mode")
+	perResult := digestModeSet && !digestMode.(bool)
+	if err := requireSuppressFieldsForPerResult(d.Get("alert_suppress").(bool), perResult, d.Get("alert_suppress_fields").(string)); err != nil {
+		return err
+	}
+	if err := validateEmbedEligibility(d.Get("embed_enabled").(bool), d.Get("is_scheduled").(bool), d.Get("disabled").(bool)); err != nil {
+		return err
+	}
+	// Unknown email groups fail at plan time rather than mid-apply.
+	if provider, ok := meta.(*SplunkProvider); ok && provider != nil {
+		if err := validateWorkloadPool(d, provider); err != nil {
+			return err
+		}
+		if provider.ValidateSearchSyntax {
+			if search := d.Get("search").(string); search != "" {
+				if err := checkSearchParses(provider, search); err != nil {
+					return err
+				}
+			}
+		}
+		if groups, ok := d.GetOk("action_email_to_groups"); ok {
+			names := []string{}
+			for _, group := range groups.([]interface{}) {
+				names = append(names, group.(string))
+			}
+			if _, err := expandEmailGroups(names, provider.EmailGroups); err != nil {
+				return err
+			}
+		}
+	}
+	warnOrphanedActionParams(d)
+	if missing := missingActionParams(d.Get("actions").(string), resourceDiffIsSet(d)); len(missing) > 0 {
+		return fmt.Errorf("missing required action parameters: %s", strings.Join(missing, "; "))
+	}
+	if allowed, ok := d.GetOk("allowed_indexes"); ok {
+		allowedList := make([]string, 0, len(allowed.([]interface{})))
+		for _, index := range allowed.([]interface{}) {
+			allowedList = append(allowedList, index.(string))
+		}
+		violations, indexWarnings := disallowedIndexes(d.Get("search").(string), allowedList)
+		for _, warning := range indexWarnings {
+			log.Printf("[WARN] %s", warning)
+		}
+		if len(violations) > 0 {
+			return fmt.Errorf("search references indexes outside allowed_indexes: %s", strings.Join(violations, ", "))
+		}
+	}
+	if warning := runOnStartupWarning(d.Get("run_on_startup").(bool), d.Get("is_scheduled").(bool), d.Get("search").(string)); warning != "" {
+		log.Printf("[WARN] %s", warning)
+	}
+	return nil
+}
+
+// timeSpecifierPattern matches Splunk's [number][time-unit] form, where a bare
+// number means seconds.
+var timeSpecifierPattern = regexp.MustCompile(`^[0-9]+(s|m|h|d|w)?$`)
+
+// validateTimeSpecifier validates a [number][time-unit] string such as 60, 5m
+// or 24h.
+func validateTimeSpecifier(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	if !timeSpecifierPattern.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s: expected a [number][time-unit] value such as 60, 5m or 24h, got %q", k, value))
+	}
+	return
+}
+
+// validateSchedulingConsistency errors on two scheduling footguns: a search
+// marked scheduled without a cron_schedule never runs, and alert actions
+// combined with an explicitly unscheduled search never fire.
+func validateSchedulingConsistency(isScheduled, isScheduledSet bool, cronSchedule, actions string) error {
+	if isScheduled && strings.TrimSpace(cronSchedule) == "" {
+		return errors.New("is_scheduled = true requires a cron_schedule, otherwise the search never runs")
+	}
+	if isScheduledSet && !isScheduled && cronSchedule != "" && normalizeActionsString(actions) != "" {
+		return errors.New("the configured actions only fire on scheduled runs; set is_scheduled = true")
+	}
+	return nil
+}
+
+// validateEmbedEligibility errors when embedding is requested for a search
+// that cannot be embedded: Splunk only issues embed tokens for scheduled,
+// enabled searches.
+func validateEmbedEligibility(embedEnabled, isScheduled, disabled bool) error {
+	if embedEnabled && (!isScheduled || disabled) {
+		return errors.New("embed_enabled requires the search to be scheduled (is_scheduled = true) and not disabled")
+	}
+	return nil
+}
+
+// reconcileEmbedACL reconciles the server-reported ACL with the configured one
+// when embedding is enabled. Splunk's embed endpoint shares the search globally
+// with read access for everyone so the embed token works unauthenticated;
+// keeping the configured sharing and read values prevents that side effect
+// from surfacing as a perpetual acl diff.
+func reconcileEmbedACL(flattened, configured []interface{}, embedEnabled bool) []interface{} {
+	if !embedEnabled || len(flattened) == 0 || len(configured) == 0 {
+		return flattened
+	}
+	server, ok := flattened[0].(map[string]interface{})
+	if !ok {
+		return flattened
+	}
+	state, ok := configured[0].(map[string]interface{})
+	if !ok {
+		return flattened
+	}
+	if server["sharing"] == "global" {
+		if sharing, ok := state["sharing"].(string); ok && sharing != "" && sharing != "global" {
+			server["sharing"] = sharing
+		}
+	}
+	if read, ok := server["read"].([]string); ok && len(read) == 1 && read[0] == "*" {
+		if stateRead, ok := state["read"].([]interface{}); ok && len(stateRead) > 0 {
+			server["read"] = stateRead
+		}
+	}
+	return flattened
+}
+
+// requireSuppressFieldsForPerResult errors when suppression is enabled for
+// per-result alerting without naming the fields to suppress on. Splunk
+// accepts such a config but silently ignores the suppression.
+func requireSuppressFieldsForPerResult(alertSuppress, perResultAlerting bool, suppressFields string) error {
+	if alertSuppress && perResultAlerting && suppressFields == "" {
+		return errors.New("alert_suppress with per-result alerting (alert_digest_mode = false) requires alert_suppress_fields")
+	}
+	return nil
+}
+
+// requireComparatorThresholdPair errors when only one of alert_comparator and
+// alert_threshold is configured; a comparator without a threshold (or the
+// reverse) never triggers and is almost always a config mistake.
+func requireComparatorThresholdPair(comparator, threshold string) error {
+	if comparator != "" && threshold == "" {
+		return errors.New("alert_comparator is set but alert_threshold is empty; both must be configured for the alert to trigger")
+	}
+	if threshold != "" && comparator == "" {
+		return errors.New("alert_threshold is set but alert_comparator is empty; both must be configured for the alert to trigger")
+	}
+	return nil
+}
+
+// runOnStartupWarning returns a warning when run_on_startup is enabled on a
+// search it is not intended for. The flag exists for lookup-populating
+// scheduled searches; enabling it broadly just adds startup load.
+func runOnStartupWarning(runOnStartup, isScheduled bool, search string) string {
+	if !runOnStartup {
+		return ""
+	}
+	if !isScheduled {
+		return "run_on_startup is enabled but the search is not scheduled, so it only adds startup load"
+	}
+	if !strings.Contains(strings.ToLower(search), "outputlookup") {
+		return "run_on_startup is intended for lookup-populating searches, but the search does not use outputlookup"
+	}
+	return ""
+}
+
+// actionParamAttributePrefixes maps each alert action to the prefix its
+// parameter attributes share in the resource schema.
+var actionParamAttributePrefixes = map[string]string{
+	"slack":                 "action_slack_param_",
+	"pagerduty":             "action_pagerduty_",
+	"jira_service_desk":     "action_jira_service_desk_param_",
+	"snow_event":            "action_snow_event_param_",
+	"webhook":               "action_webhook_param_",
+	"create_xsoar_incident": "action_create_xsoar_incident_param_",
+}
+
+// resourceDiffIsSet adapts a ResourceDiff into the isSet predicate shared by
+// the action parameter checks.
+func resourceDiffIsSet(d *schema.ResourceDiff) func(key string) bool {
+	resourceSchema := savedSearches().Schema
+	return func(key string) bool {
+		value, ok := d.GetOk(key)
+		if !ok {
+			return false
+		}
+		// GetOk cannot tell a configured value apart from a schema default
+		// (e.g. action_slack_param_attachment defaults to "none"), so a value
+		// equal to the attribute's default is treated as unset.
+		if s := resourceSchema[key]; s != nil && s.Default != nil && value == s.Default {
+			return false
+		}
+		if s, isString := value.(string); isString {
+			return s != ""
+		}
+		return true
+	}
+}
+
+// warnOrphanedActionParams logs a warning for every action parameter that is
+// configured while its action is missing from the actions list, since such
+// parameters are silently ignored by Splunk.
+func warnOrphanedActionParams(d *schema.ResourceDiff) {
+	warnings := orphanedActionParamWarnings(d.Get("actions").(string), resourceDiffIsSet(d))
+	for _, warning := range warnings {
+		log.Printf("[WARN] %s", warning)
+	}
+}
+
+// searchIndexPattern matches index= clauses in SPL, with or without quoting.
+var searchIndexPattern = regexp.MustCompile(`(?i)\bindex\s*=\s*(?:"([^"]*)"|([^\s"|)]+))`)
+
+// disallowedIndexes checks the index= clauses of a search against an
+// allowlist. Indexes that cannot be resolved statically — wildcard values and
+// searches that expand macros — are reported as warnings rather than
+// violations.
+func disallowedIndexes(search string, allowed []string) (violations, warnings []string) {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, index := range allowed {
+		allowedSet[strings.ToLower(index)] = true
+	}
+
+	if strings.Contains(search, "`") {
+		warnings = append(warnings, "search expands macros, so the full set of referenced indexes cannot be verified against allowed_indexes")
+	}
+
+	for _, match := range searchIndexPattern.FindAllStringSubmatch(search, -1) {
+		index := match[1]
+		if index == "" {
+			index = match[2]
+		}
+		if strings.ContainsAny(index, "*%") {
+			warnings = append(warnings, fmt.Sprintf("index pattern %q cannot be verified against allowed_indexes", index))
+			continue
+		}
+		if !allowedSet[strings.ToLower(index)] {
+			violations = append(violations, index)
+		}
+	}
+	sort.Strings(violations)
+	return violations, warnings
+}
+
+// requiredActionParams maps each alert action to the parameters it cannot
+// function without. Splunk accepts a config missing these but the resulting
+// alert silently does nothing; keeping the rules in a table makes new actions
+// a one-line addition. Each inner list holds alternatives: configuring any one
+// of them satisfies the requirement, e.g. email recipients can come from
+// action_email_to directly or be expanded from groups or a lookup at apply
+// time.
+var requiredActionParams = map[string][][]string{
+	"email":    {{"action_email_to", "action_email_to_groups", "action_email_to_lookup"}},
+	"slack":    {{"action_slack_param_channel"}},
+	"webhook":  {{"action_webhook_param_url"}},
+	"telegram": {{"action_telegram_param_bot_token"}, {"action_telegram_param_chat_id"}},
+	"discord":  {{"action_discord_param_webhook_url"}},
+}
+
+// missingActionParams returns one message per required parameter that is not
+// configured for an enabled action. isSet reports whether the named attribute
+// is configured.
+func missingActionParams(actions string, isSet func(key string) bool) []string {
+	var missing []string
+	for _, action := range strings.Split(normalizeActionsString(actions), ",") {
+		for _, alternatives := range requiredActionParams[action] {
+			satisfied := false
+			for _, param := range alternatives {
+				if isSet(param) {
+					satisfied = true
+					break
+				}
+			}
+			if !satisfied {
+				missing = append(missing, fmt.Sprintf("action %q requires %s", action, strings.Join(alternatives, " or ")))
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// orphanedActionParamWarnings returns one message per configured parameter
+// whose action is absent from the actions list. isSet reports whether the
+// named attribute is configured.
+func orphanedActionParamWarnings(actions string, isSet func(key string) bool) []string {
+	enabled := make(map[string]bool)
+	for _, action := range strings.Split(normalizeActionsString(actions), ",") {
+		enabled[action] = true
+	}
+
+	resourceSchema := savedSearches().Schema
+	var warnings []string
+	for action, prefix := range actionParamAttributePrefixes {
+		if enabled[action] {
+			continue
+		}
+		for key := range resourceSchema {
+			if strings.HasPrefix(key, prefix) && isSet(key) {
+				warnings = append(warnings, fmt.Sprintf("%s is configured but %q is not in the actions list, so it has no effect", key, action))
+			}
+		}
+	}
+	sort.Strings(warnings)
+	return warnings
+}
+
+// requireSuppressionForGroupName rejects plans that name a suppression group
+// without enabling per-result suppression, since Splunk silently ignores
+// alert.suppress.group_name in that case.
+func requireSuppressionForGroupName(d *schema.ResourceDiff) error {
+	if d.Get("alert_suppress_group_name").(string) == "" {
+		return nil
+	}
+	if !d.Get("alert_suppress").(bool) || d.Get("alert_suppress_fields").(string) == "" {
+		return fmt.Errorf("alert_suppress_group_name requires alert_suppress to be enabled and alert_suppress_fields to be set")
+	}
+	return nil
+}
+
+// requireAutoSummarizeCommand rejects plans that enable auto summarization
+// with a non-default timespan but no summarization template, since Splunk
+// would silently build summaries with the stock template in that case.
+func requireAutoSummarizeCommand(d *schema.ResourceDiff) error {
+	if !d.Get("auto_summarize").(bool) {
+		return nil
+	}
+	if d.Get("auto_summarize_timespan").(string) == "" {
+		return nil
+	}
+	if d.Get("auto_summarize_command").(string) == "" {
+		return fmt.Errorf("auto_summarize_command must be set when auto_summarize is enabled with a non-default auto_summarize_timespan")
+	}
+	return nil
+}
+
+// validateAutoSummarizeCommand checks an auto summarization template contains
+// a summarization directive; templates without one produce broken summaries.
+func validateAutoSummarizeCommand(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	if !strings.Contains(value, "summarize") && !strings.Contains(value, "sistats") {
+		errors = append(errors, fmt.Errorf("%q must contain a summarization directive such as \"summarize\": %s", k, value))
+	}
+	return
+}
+
+// exceedsAttachmentThreshold reports whether attached email results would
+// exceed the configured warning threshold. A threshold of zero disables the
+// guard.
+func exceedsAttachmentThreshold(sendResults bool, maxResults, threshold int) bool {
+	return sendResults && threshold > 0 && maxResults > threshold
+}
+
+// warnLargeEmailAttachment logs a warning when search results are attached to
+// emails and action_email_max_results exceeds the configured threshold, since
+// oversized attachments often fail delivery. This is a soft guard only.
+func warnLargeEmailAttachment(d *schema.ResourceDiff) {
+	sendResults := d.Get("action_email_send_results").(bool)
+	maxResults := d.Get("action_email_max_results").(int)
+	threshold := d.Get("email_attachment_warning_threshold").(int)
+	if exceedsAttachmentThreshold(sendResults, maxResults, threshold) {
+		log.Printf("[WARN] action_email_max_results (%d) exceeds the attachment warning threshold (%d); large email attachments often fail delivery", maxResults, threshold)
+	}
+}
+
+// validateScheduleWindow accepts a non-negative number of minutes or auto.
+func validateScheduleWindow(v interface{}, k string) ([]string, []error) {
+	value := v.(string)
+	if value == "" || value == "auto" {
+		return nil, nil
+	}
+	if window, err := strconv.Atoi(value); err != nil || window < 0 {
+		return nil, []error{fmt.Errorf("%s must be a non-negative integer (minutes) or auto, got: %s", k, value)}
+	}
+	return nil, nil
+}
+
+// warnScheduleWindowExceedsPeriod logs a warning when schedule_window is at
+// least as large as the period implied by cron_schedule. Splunk silently caps
+// such windows, which then shows up as drift.
+func warnScheduleWindowExceedsPeriod(d *schema.ResourceDiff) {
+	if warning := scheduleWindowPeriodWarning(d.Get("schedule_window").(string), d.Get("cron_schedule").(string)); warning != "" {
+		log.Printf("[WARN] %s", warning)
+	}
+}
+
+// scheduleWindowPeriodWarning returns the warning text when a numeric
+// schedule_window meets or exceeds the period implied by cron_schedule, or an
+// empty string when the window fits or the period cannot be determined.
+func scheduleWindowPeriodWarning(scheduleWindow, cronSchedule string) string {
+	window, err := strconv.Atoi(scheduleWindow)
+	if err != nil || window <= 0 {
+		return ""
+	}
+	period := cronPeriodMinutes(cronSchedule)
+	if period > 0 && window >= period {
+		return fmt.Sprintf("schedule_window (%dm) is not smaller than the search period (%dm) implied by cron_schedule; Splunk will cap it", window, period)
+	}
+	return ""
+}
+
+// validateEmailList validates a comma- or semicolon-separated list of email
+// recipients, naming the first invalid address. Empty values stay valid since
+// the recipient fields are optional.
+func validateEmailList(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	for _, address := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' }) {
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
+		if _, err := mail.ParseAddress(address); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid email address %q: %s", k, address, err))
+			return
+		}
+	}
+	return
+}
+
+// strptimeDirectives lists the conversion specifiers Splunk supports in
+// dispatch time formats, per the strptime documentation in savedsearches.conf.
+var strptimeDirectives = map[byte]bool{
+	'a': true, 'A': true, 'b': true, 'B': true, 'c': true, 'C': true,
+	'd': true, 'e': true, 'F': true, 'H': true, 'I': true, 'j': true,
+	'k': true, 'm': true, 'M': true, 'N': true, 'p': true, 'q': true,
+	'Q': true, 's': true, 'S': true, 'T': true, 'u': true, 'U': true,
+	'V': true, 'w': true, 'x': true, 'X': true, 'y': true, 'Y': true,
+	'z': true, 'Z': true, '%': true,
+}
+
+// validateStrptimeFormat rejects dispatch time formats containing directives
+// Splunk does not support, which would otherwise break absolute time parsing
+// at dispatch time.
+func validateStrptimeFormat(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	for i := 0; i < len(value); i++ {
+		if value[i] != '%' {
+			continue
+		}
+		rest := value[i+1:]
+		switch {
+		case strings.HasPrefix(rest, "::z"):
+			i += 3
+		case strings.HasPrefix(rest, ":z"):
+			i += 2
+		case rest == "":
+			errors = append(errors, fmt.Errorf("%q has a trailing %% in time format: %s", k, value))
+			return
+		case strptimeDirectives[rest[0]]:
+			i++
+		default:
+			errors = append(errors, fmt.Errorf("%q contains unsupported strptime directive %%%c: %s", k, rest[0], value))
+			return
+		}
+	}
+	return
+}
+
+// suppressMaskedPasswordDiff suppresses diffs caused by Splunk masking stored
+// SMTP passwords on read. Splunk returns either a masked placeholder or the
+// encrypted ciphertext instead of the configured clear text value, so neither
+// representation signals an actual change.
+func suppressMaskedPasswordDiff(k, old, new string, d *schema.ResourceData) bool {
+	return isMaskedPassword(old) && new != ""
+}
+
+// isMaskedPassword reports whether a password value returned by Splunk is a
+// masked placeholder or encrypted ciphertext rather than clear text.
+func isMaskedPassword(value string) bool {
+	if value != "" && strings.Trim(value, "*") == "" {
+		return true
+	}
+	return strings.HasPrefix(value, "$1$") || strings.HasPrefix(value, "$7$")
+}
+
+// suppressCronEquivalentDiff suppresses diffs between cron expressions that
+// match the same schedule, since Splunk sometimes normalizes */N steps to an
+// expanded list on read.
+func suppressCronEquivalentDiff(k, old, new string, d *schema.ResourceData) bool {
+	return cronFieldsEquivalent(old, new)
+}
+
+// cronFieldsEquivalent reports whether two cron expressions match the same
+// schedule by expanding each field (including step and range syntax) into the
+// set of values it matches. Fields that cannot be expanded fall back to
+// string comparison.
+func cronFieldsEquivalent(a, b string) bool {
+	if a == b {
+		return true
+	}
+	fieldsA, fieldsB := strings.Fields(a), strings.Fields(b)
+	if len(fieldsA) != 5 || len(fieldsB) != 5 {
+		return false
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+	for i := range fieldsA {
+		setA, okA := expandCronField(fieldsA[i], bounds[i][0], bounds[i][1])
+		setB, okB := expandCronField(fieldsB[i], bounds[i][0], bounds[i][1])
+		if !okA || !okB {
+			if fieldsA[i] != fieldsB[i] {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(setA, setB) {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveScheduleWindow reports the concrete window the scheduler works with.
+// A fixed schedule_window passes through unchanged; for auto the scheduler
+// caps the window at the search period implied by the cron schedule, so the
+// period is the window auto resolves to. Returns auto unchanged when the
+// period cannot be determined.
+func resolveScheduleWindow(scheduleWindow, cronSchedule string) string {
+	if scheduleWindow != "auto" {
+		return scheduleWindow
+	}
+	if period := cronPeriodMinutes(cronSchedule); period > 0 {
+		return strconv.Itoa(period)
+	}
+	return scheduleWindow
+}
+
+// validateCronSchedule rejects values that are not five-field cron
+// expressions with every field in range.
+func validateCronSchedule(v interface{}, k string) (ws []string, errors []error) {
+	fields := strings.Fields(v.(string))
+	if len(fields) != 5 {
+		errors = append(errors, fmt.Errorf("%s must be a five-field cron expression, got: %q", k, v))
+		return
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+	for i, field := range fields {
+		if _, ok := expandCronField(field, bounds[i][0], bounds[i][1]); !ok {
+			errors = append(errors, fmt.Errorf("%s field %q is not a valid cron field", k, field))
+		}
+	}
+	return
+}
+
+// expandCronField expands a cron field into the sorted set of values it
+// matches within [min, max]. Supports wildcards, lists, ranges, and steps.
+func expandCronField(field string, min, max int) ([]int, bool) {
+	set := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		rangePart := part
+		if base, stepValue, found := strings.Cut(part, "/"); found {
+			n, err := strconv.Atoi(stepValue)
+			if err != nil || n <= 0 {
+				return nil, false
+			}
+			step = n
+			rangePart = base
+		}
+
+		lo, hi := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			loValue, hiValue, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = strconv.Atoi(loValue); err != nil {
+				return nil, false
+			}
+			if hi, err = strconv.Atoi(hiValue); err != nil {
+				return nil, false
+			}
+		default:
+			n, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return nil, false
+			}
+			lo, hi = n, n
+		}
+		if lo < min || hi > max || lo > hi {
+			return nil, false
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+
+	values := make([]int, 0, len(set))
+	for v := range set {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+	return values, true
+}
+
+// cronPeriodMinutes estimates the period, in minutes, implied by a cron
+// schedule. Returns 0 when the period cannot be determined.
+func cronPeriodMinutes(cron string) int {
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return 0
+	}
+
+	minute, hour := fields[0], fields[1]
+	switch {
+	case strings.HasPrefix(minute, "*/"):
+		if n, err := strconv.Atoi(minute[2:]); err == nil && n > 0 {
+			return n
+		}
+	case minute == "*":
+		return 1
+	default:
+		if _, err := strconv.Atoi(minute); err != nil {
+			return 0
+		}
+		if hour == "*" {
+			return 60
+		}
+		if strings.HasPrefix(hour, "*/") {
+			if n, err := strconv.Atoi(hour[2:]); err == nil && n > 0 {
+				return n * 60
+			}
+		}
+	}
+	return 0
+}
+
+func resourceAlertTrackV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"alert_track": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Valid values: (true | false | auto) Specifies whether to track the actions triggered by this scheduled search." +
+					"auto - determine whether to track or not based on the tracking setting of each action, do not track scheduled searches that always trigger actions. " +
+					"Default value true - force alert tracking.false - disable alert tracking for this search.",
+			},
+		},
+	}
+}
+
+func resourceAlertTrackStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	rawState["alert_track"], _ = strconv.ParseBool(rawState["alert_track"].(string))
+	return rawState, nil
+}
+
+// validateJiraIssueType warns when the configured JIRA issue type is not among
+// the issue types the JIRA Service Desk add-on reports for the project. The
+// check is advisory only: lookup failures are logged rather than returned,
+// since the add-on's REST handler may not be installed.
+func validateJiraIssueType(d *schema.ResourceData, meta interface{}) {
+	if !d.Get("validate_jira_issue_type").(bool) {
+		return
+	}
+	issueType := d.Get("action_jira_service_desk_param_jira_issue_type").(string)
+	if issueType == "" {
+		return
+	}
+
+	provider := meta.(*SplunkProvider)
+	project := d.Get("action_jira_service_desk_param_jira_project").(string)
+	issueTypes, err := (*provider.Client).GetJiraServiceDeskIssueTypes(d.Get("action_jira_service_desk_param_account").(string), project)
+	if err != nil {
+		log.Printf("[WARN] Unable to validate JIRA issue type %q: %s", issueType, err)
+		return
+	}
+
+	for _, valid := range issueTypes {
+		if valid == issueType {
+			return
+		}
+	}
+	log.Printf("[WARN] JIRA issue type %q is not valid for project %q (valid types: %s)", issueType, project, strings.Join(issueTypes, ", "))
+}
+
+// checkWebhookReachability performs a HEAD request against the webhook URL and
+// returns an error when it cannot be reached. The check runs from the host
+// running Terraform, which may have different network access than the Splunk
+// server, so callers should treat a failure as advisory.
+func checkWebhookReachability(webhookURL string) error {
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	resp, err := httpClient.Head(webhookURL)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func validateWebhookReachability(d *schema.ResourceData) {
+	if !d.Get("validate_webhook_url_reachability").(bool) {
+		return
+	}
+	webhookURL := d.Get("action_webhook_param_url").(string)
+	if webhookURL == "" {
+		return
+	}
+	if err := checkWebhookReachability(webhookURL); err != nil {
+		log.Printf("[WARN] Webhook URL %q is not reachable from the Terraform host (the Splunk server may still be able to reach it): %s", webhookURL, err)
+	}
 }
 
 func savedSearchesCreate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*SplunkProvider)
 	name := d.Get("name").(string)
+	validateJiraIssueType(d, meta)
+	validateWebhookReachability(d)
 	savedSearchesConfig := getSavedSearchesConfig(d)
+	if err := applyEmailGroups(d, provider, savedSearchesConfig); err != nil {
+		return err
+	}
+	if err := applyEmailLookup(d, provider, savedSearchesConfig); err != nil {
+		return err
+	}
 	aclObject := getResourceDataSearchACL(d)
 	err := (*provider.Client).CreateSavedSearches(name, aclObject.Owner, aclObject.App, savedSearchesConfig)
 	if err != nil {
 		return err
 	}
 
+	if err := applyWebhookHeaders(d, provider, name, aclObject.Owner, aclObject.App); err != nil {
+		return fmt.Errorf("savedSearchesCreate: webhook headers: %s", err)
+	}
+
 	if _, ok := d.GetOk("acl"); ok {
 		if err := (*provider.Client).UpdateAcl(aclObject.Owner, aclObject.App, name, aclObject, "saved", "searches"); err != nil {
 			return fmt.Errorf("savedSearchesCreate: updateacl: %s\n%#v", err, aclObject)
 		}
+	} else if source, ok := d.GetOk("acl_from"); ok {
+		clonedACL, err := readSavedSearchACL(provider, source.(string), aclObject.Owner, aclObject.App)
+		if err != nil {
+			return fmt.Errorf("savedSearchesCreate: acl_from: %s", err)
+		}
+		if err := (*provider.Client).UpdateAcl(aclObject.Owner, aclObject.App, name, clonedACL, "saved", "searches"); err != nil {
+			return fmt.Errorf("savedSearchesCreate: updateacl: %s\n%#v", err, clonedACL)
+		}
+	}
+
+	if d.Get("run_on_create").(bool) {
+		sid, err := (*provider.Client).DispatchSavedSearch(name, aclObject.Owner, aclObject.App)
+		if err != nil {
+			log.Printf("[WARN] Unable to dispatch saved search %q after create: %s", name, err)
+		} else if err := d.Set("last_dispatch_sid", sid); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("embed_enabled").(bool) {
+		if err := (*provider.Client).ToggleSavedSearchEmbed(name, aclObject.Owner, aclObject.App, true); err != nil {
+			return fmt.Errorf("savedSearchesCreate: embed: %s", err)
+		}
 	}
 
 	d.SetId(name)
 	return savedSearchesRead(d, meta)
 }
 
+// readSavedSearchACL fetches the ACL of an existing saved search so it can be
+// cloned onto a newly created one.
+func readSavedSearchACL(provider *SplunkProvider, name, owner, app string) (*models.ACLObject, error) {
+	resp, err := (*provider.Client).GetAcl(owner, app, name, "saved", "searches")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	aclResponse := &models.ACLResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(aclResponse); err != nil {
+		return nil, err
+	}
+	if len(aclResponse.Entry) != 1 {
+		return nil, fmt.Errorf("ACLResponse has %d entries, expected exactly 1", len(aclResponse.Entry))
+	}
+	return &aclResponse.Entry[0].Content, nil
+}
+
 func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*SplunkProvider)
 	name := d.Id()
 
 	aclObject := getResourceDataSearchACL(d)
+	// The ACL update during create may have moved the object out of the
+	// configured owner's namespace, so resolve the effective owner here.
+	owner := resolveSavedSearchOwner(aclObject)
 
-	resp, err := (*provider.Client).ReadSavedSearches(name, aclObject.Owner, aclObject.App)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+	var entry *models.SavedSearchesEntry
+	// retry reads that fail mid-decode so a network blip does not masquerade as a deleted resource
+	err := retry.Do(
+		func() error {
+			resp, err := (*provider.Client).ReadSavedSearches(name, owner, aclObject.App)
+			if err != nil {
+				return retry.Unrecoverable(err)
+			}
+			defer resp.Body.Close()
 
-	entry, err := getSavedSearchesConfigByName(name, resp)
+			entry, err = getSavedSearchesConfigByName(name, resp)
+			return err
+		}, retry.Attempts(3), retry.OnRetry(func(n uint, err error) {
+			log.Printf("#%d: %s. Retrying...\n", n, err)
+		}), retry.DelayType(retry.BackOffDelay), retry.LastErrorOnly(true),
+	)
 	if err != nil {
 		return err
 	}
@@ -1150,7 +3041,13 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("name", d.Id()); err != nil {
 		return err
 	}
-	if err = d.Set("actions", normalizeActionsString(entry.Content.Actions)); err != nil {
+	if err = d.Set("actions", syncWebhookAction(entry.Content.Actions, entry.Content.ActionWebhookParamUrl)); err != nil {
+		return err
+	}
+	if err = d.Set("actions_raw", entry.Content.Actions); err != nil {
+		return err
+	}
+	if err = d.Set("action_summary", summarizeActions(&entry.Content)); err != nil {
 		return err
 	}
 	if err = d.Set("action_snow_event_param_account", entry.Content.ActionSnowEventParamAccount); err != nil {
@@ -1177,15 +3074,28 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_snow_event_param_custom_fields", entry.Content.ActionSnowEventParamCustomFields); err != nil {
 		return err
 	}
-	if err = d.Set("action_snow_event_param_additional_info", entry.Content.ActionSnowEventParamAdditionalInfo); err != nil {
+	additionalInfo := entry.Content.ActionSnowEventParamAdditionalInfo
+	if _, ok := d.GetOk("action_snow_event_drilldown_url"); ok {
+		drilldownURL, rest := extractSnowDrilldownURL(additionalInfo)
+		if err = d.Set("action_snow_event_drilldown_url", drilldownURL); err != nil {
+			return err
+		}
+		additionalInfo = rest
+	}
+	if err = d.Set("action_snow_event_param_additional_info", additionalInfo); err != nil {
 		return err
 	}
 	if err = d.Set("action_email", entry.Content.ActionEmail); err != nil {
 		return err
 	}
-	if err = d.Set("action_email_auth_password", entry.Content.ActionEmailAuthPassword); err != nil {
+	if err = d.Set("action_email_auth_mechanism", entry.Content.ActionEmailAuthMechanism); err != nil {
 		return err
 	}
+	for field, fromServer := range sensitiveCredentialFields(&entry.Content) {
+		if err = d.Set(field, credentialStateValue(d.Get(field).(string), fromServer)); err != nil {
+			return err
+		}
+	}
 	if err = d.Set("action_email_auth_username", entry.Content.ActionEmailAuthUsername); err != nil {
 		return err
 	}
@@ -1204,24 +3114,30 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_email_from", entry.Content.ActionEmailFrom); err != nil {
 		return err
 	}
-	if err = d.Set("action_email_include_results_link", entry.Content.ActionEmailIncludeResultsLink); err != nil {
+	if err = d.Set("action_email_graceful", entry.Content.ActionEmailGraceful); err != nil {
 		return err
 	}
-	if err = d.Set("action_email_include_search", entry.Content.ActionEmailIncludeSearch); err != nil {
+	if err = d.Set("action_email_include_results_link", strconv.Itoa(entry.Content.ActionEmailIncludeResultsLink)); err != nil {
 		return err
 	}
-	if err = d.Set("action_email_include_trigger", entry.Content.ActionEmailIncludeTrigger); err != nil {
+	if err = d.Set("action_email_include_search", strconv.Itoa(entry.Content.ActionEmailIncludeSearch)); err != nil {
 		return err
 	}
-	if err = d.Set("action_email_include_trigger_time", entry.Content.ActionEmailIncludeTriggerTime); err != nil {
+	if err = d.Set("action_email_include_trigger", strconv.Itoa(entry.Content.ActionEmailIncludeTrigger)); err != nil {
 		return err
 	}
-	if err = d.Set("action_email_include_view_link", entry.Content.ActionEmailIncludeViewLink); err != nil {
+	if err = d.Set("action_email_include_trigger_time", strconv.Itoa(entry.Content.ActionEmailIncludeTriggerTime)); err != nil {
+		return err
+	}
+	if err = d.Set("action_email_include_view_link", strconv.Itoa(entry.Content.ActionEmailIncludeViewLink)); err != nil {
 		return err
 	}
 	if err = d.Set("action_email_inline", entry.Content.ActionEmailInline); err != nil {
 		return err
 	}
+	if err = d.Set("action_email_locale", entry.Content.ActionEmailLocale); err != nil {
+		return err
+	}
 	if err = d.Set("action_email_mailserver", entry.Content.ActionEmailMailserver); err != nil {
 		return err
 	}
@@ -1246,6 +3162,9 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_email_report_cid_font_list", entry.Content.ActionEmailReportCIDFontList); err != nil {
 		return err
 	}
+	if err = d.Set("action_email_report_file_name", entry.Content.ActionEmailReportFileName); err != nil {
+		return err
+	}
 	if err = d.Set("action_email_report_include_splunk_logo", entry.Content.ActionEmailReportIncludeSplunkLogo); err != nil {
 		return err
 	}
@@ -1267,6 +3186,13 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_email_send_pdf", entry.Content.ActionEmailSendPDF); err != nil {
 		return err
 	}
+	// Only refresh the pdf_delivery block when it is in use; the flat fields
+	// remain the source of truth otherwise.
+	if _, ok := d.GetOk("pdf_delivery"); ok {
+		if err = d.Set("pdf_delivery", []interface{}{flattenPDFDelivery(&entry.Content)}); err != nil {
+			return err
+		}
+	}
 	if err = d.Set("action_email_send_results", entry.Content.ActionEmailSendResults); err != nil {
 		return err
 	}
@@ -1279,12 +3205,32 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_email_to", entry.Content.ActionEmailTo); err != nil {
 		return err
 	}
+	// Collapse the recipient list back onto the configured groups when it
+	// still matches their expansion; otherwise flag the out-of-band edit.
+	if groups, ok := d.GetOk("action_email_to_groups"); ok {
+		names := []string{}
+		for _, group := range groups.([]interface{}) {
+			names = append(names, group.(string))
+		}
+		if expanded, expandErr := expandEmailGroups(names, provider.EmailGroups); expandErr == nil {
+			if normalizeDelimitedString(expanded) == normalizeDelimitedString(entry.Content.ActionEmailTo) {
+				if err = d.Set("action_email_to", expanded); err != nil {
+					return err
+				}
+			} else {
+				log.Printf("[WARN] Saved search %q recipients %q no longer match the expansion of groups %v", name, entry.Content.ActionEmailTo, names)
+			}
+		}
+	}
 	if err = d.Set("action_email_track_alert", entry.Content.ActionEmailTrackAlert); err != nil {
 		return err
 	}
 	if err = d.Set("action_email_ttl", entry.Content.ActionEmailTTL); err != nil {
 		return err
 	}
+	if err = d.Set("action_email_use_ns_subject", entry.Content.ActionEmailUseNSSubject); err != nil {
+		return err
+	}
 	if err = d.Set("action_email_use_ssl", entry.Content.ActionEmailUseSSL); err != nil {
 		return err
 	}
@@ -1297,6 +3243,23 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_pagerduty_integration_url", entry.Content.ActionPagerdutyIntegrationURL); err != nil {
 		return err
 	}
+	if details, ok := decodePagerdutyCustomDetails(entry.Content.ActionPagerdutyParamCustDetails); ok {
+		if err = d.Set("action_pagerduty_custom_details_map", details); err != nil {
+			return err
+		}
+	}
+	if err = d.Set("action_risk", entry.Content.ActionRisk); err != nil {
+		return err
+	}
+	if err = d.Set("action_risk_param_risk", entry.Content.ActionRiskParamRisk); err != nil {
+		return err
+	}
+	if err = d.Set("action_risk_param_risk_score", entry.Content.ActionRiskParamRiskScore); err != nil {
+		return err
+	}
+	if err = d.Set("action_risk_param_risk_message", entry.Content.ActionRiskParamRiskMessage); err != nil {
+		return err
+	}
 	if err = d.Set("action_script", entry.Content.ActionScript); err != nil {
 		return err
 	}
@@ -1330,8 +3293,12 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_create_xsoar_incident_param_type", entry.Content.ActionCreateXsoarIncidentParamType); err != nil {
 		return err
 	}
-	if err = d.Set("action_slack_param_attachment", entry.Content.ActionSlackParamAttachment); err != nil {
-		return err
+	// An absent Slack app reports an empty attachment; keep the configured
+	// value rather than clobbering it with the empty server response.
+	if entry.Content.ActionSlackParamAttachment != "" {
+		if err = d.Set("action_slack_param_attachment", entry.Content.ActionSlackParamAttachment); err != nil {
+			return err
+		}
 	}
 	if err = d.Set("action_slack_param_channel", entry.Content.ActionSlackParamChannel); err != nil {
 		return err
@@ -1342,9 +3309,6 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_slack_param_message", entry.Content.ActionSlackParamMessage); err != nil {
 		return err
 	}
-	if err = d.Set("action_slack_param_webhook_url_override", entry.Content.ActionSlackParamWebhookUrlOverride); err != nil {
-		return err
-	}
 	if err = d.Set("action_jira_service_desk_param_account", entry.Content.ActionJiraServiceDeskParamAccount); err != nil {
 		return err
 	}
@@ -1370,9 +3334,72 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_jira_service_desk_param_jira_customfields", entry.Content.ActionJiraServiceDeskParamJiraCustomfields); err != nil {
 		return err
 	}
+	if err = d.Set("action_discord", entry.Content.ActionDiscord); err != nil {
+		return err
+	}
+	if err = d.Set("action_discord_param_webhook_url", entry.Content.ActionDiscordParamWebhookURL); err != nil {
+		return err
+	}
+	if err = d.Set("action_discord_param_username", entry.Content.ActionDiscordParamUsername); err != nil {
+		return err
+	}
+	if err = d.Set("action_discord_param_message", entry.Content.ActionDiscordParamMessage); err != nil {
+		return err
+	}
+	if err = d.Set("action_keyindicator", entry.Content.ActionKeyindicator); err != nil {
+		return err
+	}
+	if err = d.Set("action_keyindicator_param_title", entry.Content.ActionKeyindicatorParamTitle); err != nil {
+		return err
+	}
+	if err = d.Set("action_keyindicator_param_subtitle", entry.Content.ActionKeyindicatorParamSubtitle); err != nil {
+		return err
+	}
+	if err = d.Set("action_keyindicator_param_value_suffix", entry.Content.ActionKeyindicatorParamValueSuffix); err != nil {
+		return err
+	}
+	if err = d.Set("action_keyindicator_param_invert", entry.Content.ActionKeyindicatorParamInvert); err != nil {
+		return err
+	}
+	if err = d.Set("action_keyindicator_param_threshold", entry.Content.ActionKeyindicatorParamThreshold); err != nil {
+		return err
+	}
+	if err = d.Set("action_keyindicator_param_drilldown_uri", entry.Content.ActionKeyindicatorParamDrilldownURI); err != nil {
+		return err
+	}
+	if err = d.Set("action_notable", entry.Content.ActionNotable); err != nil {
+		return err
+	}
+	if err = d.Set("action_notable_param_rule_title", entry.Content.ActionNotableParamRuleTitle); err != nil {
+		return err
+	}
+	if err = d.Set("action_notable_param_rule_description", entry.Content.ActionNotableParamRuleDescription); err != nil {
+		return err
+	}
+	if err = d.Set("action_notable_param_security_domain", entry.Content.ActionNotableParamSecurityDomain); err != nil {
+		return err
+	}
+	if err = d.Set("action_notable_param_severity", entry.Content.ActionNotableParamSeverity); err != nil {
+		return err
+	}
+	if err = d.Set("action_notable_param_nes_fields", entry.Content.ActionNotableParamNesFields); err != nil {
+		return err
+	}
+	if err = d.Set("action_telegram", entry.Content.ActionTelegram); err != nil {
+		return err
+	}
+	if err = d.Set("action_telegram_param_chat_id", entry.Content.ActionTelegramParamChatID); err != nil {
+		return err
+	}
+	if err = d.Set("action_telegram_param_message", entry.Content.ActionTelegramParamMessage); err != nil {
+		return err
+	}
 	if err = d.Set("action_webhook_param_url", entry.Content.ActionWebhookParamUrl); err != nil {
 		return err
 	}
+	if err = d.Set("action_webhook_param_http_headers", entry.WebhookHeaders); err != nil {
+		return err
+	}
 	if err = d.Set("action_webhook", entry.Content.ActionWebhook); err != nil {
 		return err
 	}
@@ -1382,6 +3409,9 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("action_webhook_param_priority", entry.Content.ActionWebhookParamPriority); err != nil {
 		return err
 	}
+	if err = d.Set("webhook_priority_name", webhookPriorityName(entry.Content.ActionWebhookParamPriority)); err != nil {
+		return err
+	}
 	if err = d.Set("action_webhook_param_mitre_attack_id", entry.Content.ActionWebhookParamMitreAttackId); err != nil {
 		return err
 	}
@@ -1412,9 +3442,23 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("alert_suppress_fields", entry.Content.AlertSuppressFields); err != nil {
 		return err
 	}
+	if err = d.Set("alert_suppress_group_name", entry.Content.AlertSuppressGroupName); err != nil {
+		return err
+	}
 	if err = d.Set("alert_suppress_period", entry.Content.AlertSuppressPeriod); err != nil {
 		return err
 	}
+	suppressionRemaining := 0
+	if entry.Content.AlertSuppress {
+		suppressionRemaining, err = (*provider.Client).GetSavedSearchSuppressionRemaining(name, owner, aclObject.App)
+		if err != nil {
+			log.Printf("[WARN] Unable to read suppression info for %q: %s", name, err)
+			suppressionRemaining = 0
+		}
+	}
+	if err = d.Set("suppression_remaining", suppressionRemaining); err != nil {
+		return err
+	}
 	if err = d.Set("alert_track", entry.Content.AlertTrack); err != nil {
 		return err
 	}
@@ -1533,68 +3577,247 @@ func savedSearchesRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("dispatch_ttl", entry.Content.DispatchTTL); err != nil {
 		return err
 	}
+	if err = d.Set("color", entry.Content.DisplayGeneralColor); err != nil {
+		return err
+	}
 	if err = d.Set("display_view", entry.Content.DisplayView); err != nil {
 		return err
 	}
 	if err = d.Set("is_scheduled", entry.Content.IsScheduled); err != nil {
 		return err
 	}
-	if err = d.Set("is_visible", entry.Content.IsVisible); err != nil {
+	if err = d.Set("effectively_scheduled", effectivelyScheduled(entry.Content.IsScheduled, entry.Content.Disabled, entry.Content.CronSchedule)); err != nil {
+		return err
+	}
+	dispatchQuota, dispatchActive := 0, 0
+	if entry.Content.IsScheduled {
+		dispatchQuota, dispatchActive, err = dispatchUserSearchQuota(provider, owner)
+		if err != nil {
+			log.Printf("[WARN] Unable to read search job quota for %q: %s", owner, err)
+			dispatchQuota, dispatchActive = 0, 0
+		}
+	}
+	if err = d.Set("dispatch_user_search_jobs_quota", dispatchQuota); err != nil {
+		return err
+	}
+	if err = d.Set("dispatch_user_active_search_jobs", dispatchActive); err != nil {
+		return err
+	}
+	if err = d.Set("embed_enabled", entry.Content.EmbedEnabled); err != nil {
+		return err
+	}
+	if err = d.Set("embed_token", entry.Content.EmbedToken); err != nil {
+		return err
+	}
+	if err = d.Set("is_visible", entry.Content.IsVisible); err != nil {
+		return err
+	}
+	if err = d.Set("max_concurrent", entry.Content.MaxConcurrent); err != nil {
+		return err
+	}
+	if err = d.Set("realtime_schedule", entry.Content.RealtimeSchedule); err != nil {
+		return err
+	}
+	if err = d.Set("request_ui_dispatch_app", entry.Content.RequestUIDispatchApp); err != nil {
+		return err
+	}
+	if err = d.Set("request_ui_dispatch_view", entry.Content.RequestUIDispatchView); err != nil {
+		return err
+	}
+	if err = d.Set("restart_on_searchpeer_add", entry.Content.RestartOnSearchPeerAdd); err != nil {
+		return err
+	}
+	if err = d.Set("run_on_startup", entry.Content.RunOnStartup); err != nil {
+		return err
+	}
+	if err = d.Set("schedule_window", entry.Content.ScheduleWindow); err != nil {
+		return err
+	}
+	if err = d.Set("effective_schedule_window", resolveScheduleWindow(entry.Content.ScheduleWindow, entry.Content.CronSchedule)); err != nil {
+		return err
+	}
+	if err = d.Set("schedule_priority", entry.Content.SchedulePriority); err != nil {
+		return err
+	}
+	searchValue := entry.Content.Search
+	if baseName, ok := d.GetOk("base_search_name"); ok {
+		// Unwrap the generated savedsearch prefix so the child search is
+		// compared against the configured value.
+		if child, wrapped := stripBaseSearchSPL(searchValue, baseName.(string)); wrapped {
+			searchValue = child
+		}
+	}
+	if err = d.Set("search", searchValue); err != nil {
+		return err
+	}
+	if err = d.Set("vsid", entry.Content.VSID); err != nil {
+		return err
+	}
+	vsidValid := true
+	if entry.Content.VSID != "" {
+		vsidValid, err = (*provider.Client).ViewstateExists(entry.Content.VSID, owner, aclObject.App)
+		if err != nil {
+			log.Printf("[WARN] Unable to resolve vsid %q for saved search %q: %s", entry.Content.VSID, name, err)
+			vsidValid = false
+		} else if !vsidValid {
+			log.Printf("[WARN] Saved search %q references vsid %q, which does not resolve to a viewstate", name, entry.Content.VSID)
+		}
+	}
+	if err = d.Set("vsid_valid", vsidValid); err != nil {
 		return err
 	}
-	if err = d.Set("max_concurrent", entry.Content.MaxConcurrent); err != nil {
+	if err = d.Set("skipped_count", entry.Content.SkippedCount); err != nil {
 		return err
 	}
-	if err = d.Set("realtime_schedule", entry.Content.RealtimeSchedule); err != nil {
+	if err = d.Set("system_disabled", systemDisabled(entry.Content.Disabled, entry.Content.IsScheduled, entry.Content.CronSchedule, entry.Content.SkippedCount)); err != nil {
 		return err
 	}
-	if err = d.Set("request_ui_dispatch_app", entry.Content.RequestUIDispatchApp); err != nil {
+	if err = d.Set("triggered_alert_count", entry.Content.TriggeredAlertCount); err != nil {
 		return err
 	}
-	if err = d.Set("request_ui_dispatch_view", entry.Content.RequestUIDispatchView); err != nil {
+	if err = d.Set("workload_pool", entry.Content.WorkloadPool); err != nil {
 		return err
 	}
-	if err = d.Set("restart_on_searchpeer_add", entry.Content.RestartOnSearchPeerAdd); err != nil {
+
+	if err = d.Set("dependencies", []interface{}{parseSearchDependencies(entry.Content.Search)}); err != nil {
 		return err
 	}
-	if err = d.Set("run_on_startup", entry.Content.RunOnStartup); err != nil {
-		return err
+
+	if _, ok := d.GetOk("time_range"); ok {
+		if err = d.Set("time_range", flattenTimeRange(&entry.Content)); err != nil {
+			return err
+		}
 	}
-	if err = d.Set("schedule_window", entry.Content.ScheduleWindow); err != nil {
+
+	accelerationEligible := isTransformingSearch(entry.Content.Search)
+	if err = d.Set("acceleration_eligible", accelerationEligible); err != nil {
 		return err
 	}
-	if err = d.Set("schedule_priority", entry.Content.SchedulePriority); err != nil {
-		return err
+	if entry.Content.AutoSummarize && !accelerationEligible {
+		log.Printf("[WARN] Saved search %q enables auto_summarize but its search contains no transforming command, so acceleration has no effect", name)
 	}
-	if err = d.Set("search", entry.Content.Search); err != nil {
+
+	err = d.Set("acl", reconcileEmbedACL(flattenACL(&entry.ACL), d.Get("acl").([]interface{}), entry.Content.EmbedEnabled))
+	if err != nil {
 		return err
 	}
-	if err = d.Set("vsid", entry.Content.VSID); err != nil {
+	if err = d.Set("current_app", entry.ACL.App); err != nil {
 		return err
 	}
-	if err = d.Set("workload_pool", entry.Content.WorkloadPool); err != nil {
+	if err = d.Set("last_updated", entry.Updated); err != nil {
 		return err
 	}
 
-	err = d.Set("acl", flattenACL(&entry.ACL))
-	if err != nil {
-		return err
+	return nil
+}
+
+var (
+	dependencyLookupRe      = regexp.MustCompile(`(?i)\|\s*(?:input|output)?lookup\s+(?:(?:local|update|append|override_if_empty)\s*=\s*\S+\s+)*([\w.-]+)`)
+	dependencyMacroRe       = regexp.MustCompile("`([\\w.-]+?)(?:\\([^`]*\\))?`")
+	dependencyDataModelRe   = regexp.MustCompile(`(?i)datamodel(?:\s*=\s*|\s+)([\w.-]+)`)
+	dependencySavedSearchRe = regexp.MustCompile(`(?i)\|\s*savedsearch\s+"?([\w .-]+?)"?\s*(?:\||$)`)
+)
+
+// parseSearchDependencies extracts the lookups, macros, data models, and saved
+// searches referenced by an SPL search string. The parser is regex based and
+// intentionally conservative: it powers impact analysis, not a full SPL grammar.
+func parseSearchDependencies(search string) map[string]interface{} {
+	return map[string]interface{}{
+		"lookups":        matchAllUnique(dependencyLookupRe, search),
+		"macros":         matchAllUnique(dependencyMacroRe, search),
+		"data_models":    matchAllUnique(dependencyDataModelRe, search),
+		"saved_searches": matchAllUnique(dependencySavedSearchRe, search),
 	}
+}
 
-	return nil
+var transformingSearchCommands = map[string]bool{
+	"chart":     true,
+	"stats":     true,
+	"timechart": true,
+	"tstats":    true,
+}
+
+// isTransformingSearch reports whether the SPL search contains a transforming
+// command, which is a prerequisite for report acceleration.
+func isTransformingSearch(search string) bool {
+	for _, segment := range strings.Split(search, "|") {
+		fields := strings.Fields(segment)
+		if len(fields) > 0 && transformingSearchCommands[strings.ToLower(fields[0])] {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAllUnique(re *regexp.Regexp, search string) []string {
+	seen := make(map[string]bool)
+	var matches []string
+	for _, match := range re.FindAllStringSubmatch(search, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			matches = append(matches, match[1])
+		}
+	}
+	sort.Strings(matches)
+	return matches
 }
 
 func savedSearchesUpdate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*SplunkProvider)
+	validateJiraIssueType(d, meta)
+	validateWebhookReachability(d)
 	savedSearchesConfig := getSavedSearchesConfig(d)
-	aclObject := getACLConfig(d.Get("acl").([]interface{}))
+	if err := applyEmailGroups(d, provider, savedSearchesConfig); err != nil {
+		return err
+	}
+	if err := applyEmailLookup(d, provider, savedSearchesConfig); err != nil {
+		return err
+	}
+	// Apply the same pseudo-defaults as create and read so an omitted acl
+	// block never degrades into an empty namespace.
+	aclObject := getResourceDataSearchACL(d)
 
-	// Update will create a new resource with private `user` permissions if resource had shared permissions set
-	var owner string
-	if aclObject.Sharing != "user" {
-		owner = "nobody"
-	} else {
-		owner = aclObject.Owner
+	// Non-user sharing is managed through the nobody namespace by default:
+	// only the owner is rewritten while the configured app is kept, so updates
+	// target the app the object actually lives in. owner_on_update can pin the
+	// namespace instead, e.g. to keep a shared search in its author's
+	// "My Searches".
+	owner := resolveUpdateOwner(d.Get("owner_on_update").(string), aclObject)
+
+	if d.Get("detect_external_changes").(bool) {
+		if err := checkExternalModification(provider, d.Id(), owner, aclObject.App, d.Get("last_updated").(string)); err != nil {
+			return err
+		}
+	}
+
+	// A changed acl app relocates the object first, so the content update
+	// below targets the app the search now lives in.
+	if d.HasChange("acl.0.app") {
+		oldApp, newApp := d.GetChange("acl.0.app")
+		if oldApp.(string) != "" && newApp.(string) != "" && oldApp.(string) != newApp.(string) {
+			if err := moveSavedSearchToApp(d, provider, aclObject, oldApp.(string)); err != nil {
+				return fmt.Errorf("savedSearchesUpdate: move to app %q: %s", newApp, err)
+			}
+		}
+	}
+
+	if d.Get("replace_action_params").(bool) && d.HasChange("actions") {
+		oldActions, newActions := d.GetChange("actions")
+		keys := droppedActionParamKeys(oldActions.(string), newActions.(string))
+		if len(keys) > 0 {
+			if err := (*provider.Client).ClearSavedSearchActionParams(d.Id(), owner, aclObject.App, keys); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Disabling embed happens before the ACL update so the update restores the
+	// intended sharing; enabling it happens after so its global-share side
+	// effect is not clobbered by the ACL update.
+	if d.HasChange("embed_enabled") && !d.Get("embed_enabled").(bool) {
+		if err := (*provider.Client).ToggleSavedSearchEmbed(d.Id(), owner, aclObject.App, false); err != nil {
+			return fmt.Errorf("savedSearchesUpdate: embed: %s", err)
+		}
 	}
 
 	err := (*provider.Client).UpdateSavedSearches(d.Id(), owner, aclObject.App, savedSearchesConfig)
@@ -1602,53 +3825,61 @@ func savedSearchesUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.HasChange("action_webhook_param_http_headers") {
+		if err := applyWebhookHeaders(d, provider, d.Id(), owner, aclObject.App); err != nil {
+			return fmt.Errorf("savedSearchesUpdate: webhook headers: %s", err)
+		}
+	}
+
 	// Update ACL
 	err = (*provider.Client).UpdateAcl(owner, aclObject.App, d.Id(), aclObject, "saved", "searches")
 	if err != nil {
 		return err
 	}
 
+	if d.HasChange("embed_enabled") && d.Get("embed_enabled").(bool) {
+		if err := (*provider.Client).ToggleSavedSearchEmbed(d.Id(), owner, aclObject.App, true); err != nil {
+			return fmt.Errorf("savedSearchesUpdate: embed: %s", err)
+		}
+	}
+
 	return savedSearchesRead(d, meta)
 }
 
 func savedSearchesDelete(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*SplunkProvider)
-	aclObject := getACLConfig(d.Get("acl").([]interface{}))
-	resp, err := (*provider.Client).DeleteSavedSearches(d.Id(), aclObject.Owner, aclObject.App)
+	aclObject := getResourceDataSearchACL(d)
+	owner := resolveSavedSearchOwner(aclObject)
+	// Non-2xx responses already surface as a structured error from the client,
+	// including the status, the messages envelope and the request URL.
+	resp, err := (*provider.Client).DeleteSavedSearches(d.Id(), owner, aclObject.App)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
-
-	switch resp.StatusCode {
-	case 200, 201:
-		return nil
-
-	default:
-		errorResponse := &models.InputsUDPResponse{}
-		_ = json.NewDecoder(resp.Body).Decode(errorResponse)
-		err := errors.New(errorResponse.Messages[0].Text)
-		return err
-	}
+	return nil
 }
 
 func getSavedSearchesConfig(d *schema.ResourceData) (savedSearchesObj *models.SavedSearchObject) {
 	savedSearchesObj = &models.SavedSearchObject{
-		Actions:                                      normalizeActionsString(d.Get("actions").(string)),
+		Actions:                                      syncWebhookAction(d.Get("actions").(string), d.Get("action_webhook_param_url").(string)),
 		ActionEmail:                                  d.Get("action_email").(bool),
+		ActionEmailAuthMechanism:                     d.Get("action_email_auth_mechanism").(string),
 		ActionEmailAuthPassword:                      d.Get("action_email_auth_password").(string),
 		ActionEmailAuthUsername:                      d.Get("action_email_auth_username").(string),
 		ActionEmailBCC:                               d.Get("action_email_bcc").(string),
 		ActionEmailCC:                                d.Get("action_email_cc").(string),
 		ActionEmailFormat:                            d.Get("action_email_format").(string),
 		ActionEmailFrom:                              d.Get("action_email_from").(string),
+		ActionEmailGraceful:                          d.Get("action_email_graceful").(bool),
 		ActionEmailHostname:                          d.Get("action_email_hostname").(string),
-		ActionEmailIncludeResultsLink:                d.Get("action_email_include_results_link").(int),
-		ActionEmailIncludeSearch:                     d.Get("action_email_include_search").(int),
-		ActionEmailIncludeTrigger:                    d.Get("action_email_include_trigger").(int),
-		ActionEmailIncludeTriggerTime:                d.Get("action_email_include_trigger_time").(int),
-		ActionEmailIncludeViewLink:                   d.Get("action_email_include_view_link").(int),
+		ActionEmailIncludeResultsLink:                getBoolLikeInt(d, "action_email_include_results_link"),
+		ActionEmailIncludeSearch:                     getBoolLikeInt(d, "action_email_include_search"),
+		ActionEmailIncludeTrigger:                    getBoolLikeInt(d, "action_email_include_trigger"),
+		ActionEmailIncludeTriggerTime:                getBoolLikeInt(d, "action_email_include_trigger_time"),
+		ActionEmailIncludeViewLink:                   getBoolLikeInt(d, "action_email_include_view_link"),
 		ActionEmailInline:                            d.Get("action_email_inline").(bool),
+		ActionEmailLocale:                            d.Get("action_email_locale").(string),
 		ActionEmailMailserver:                        d.Get("action_email_mailserver").(string),
 		ActionEmailMaxResults:                        d.Get("action_email_max_results").(int),
 		ActionEmailMaxTime:                           d.Get("action_email_max_time").(string),
@@ -1657,6 +3888,7 @@ func getSavedSearchesConfig(d *schema.ResourceData) (savedSearchesObj *models.Sa
 		ActionEmailPDFView:                           d.Get("action_email_pdfview").(string),
 		ActionEmailPreprocessResults:                 d.Get("action_email_preprocess_results").(string),
 		ActionEmailReportCIDFontList:                 d.Get("action_email_report_cid_font_list").(string),
+		ActionEmailReportFileName:                    d.Get("action_email_report_file_name").(string),
 		ActionEmailReportIncludeSplunkLogo:           d.Get("action_email_report_include_splunk_logo").(bool),
 		ActionEmailReportPaperOrientation:            d.Get("action_email_report_paper_orientation").(string),
 		ActionEmailReportPaperSize:                   d.Get("action_email_report_paper_size").(string),
@@ -1670,6 +3902,7 @@ func getSavedSearchesConfig(d *schema.ResourceData) (savedSearchesObj *models.Sa
 		ActionEmailTo:                                d.Get("action_email_to").(string),
 		ActionEmailTrackAlert:                        d.Get("action_email_track_alert").(bool),
 		ActionEmailTTL:                               d.Get("action_email_ttl").(string),
+		ActionEmailUseNSSubject:                      d.Get("action_email_use_ns_subject").(bool),
 		ActionEmailUseSSL:                            d.Get("action_email_use_ssl").(bool),
 		ActionEmailUseTLS:                            d.Get("action_email_use_tls").(bool),
 		ActionEmailWidthSortColumns:                  d.Get("action_email_width_sort_columns").(bool),
@@ -1709,6 +3942,27 @@ func getSavedSearchesConfig(d *schema.ResourceData) (savedSearchesObj *models.Sa
 		ActionJiraServiceDeskParamJiraPriority:       d.Get("action_jira_service_desk_param_jira_priority").(string),
 		ActionJiraServiceDeskParamJiraDescription:    d.Get("action_jira_service_desk_param_jira_description").(string),
 		ActionJiraServiceDeskParamJiraCustomfields:   d.Get("action_jira_service_desk_param_jira_customfields").(string),
+		ActionDiscord:                                strings.Contains(normalizeActionsString(d.Get("actions").(string)), "discord"),
+		ActionDiscordParamWebhookURL:                 d.Get("action_discord_param_webhook_url").(string),
+		ActionDiscordParamUsername:                   d.Get("action_discord_param_username").(string),
+		ActionDiscordParamMessage:                    d.Get("action_discord_param_message").(string),
+		ActionKeyindicator:                           strings.Contains(normalizeActionsString(d.Get("actions").(string)), "keyindicator"),
+		ActionKeyindicatorParamTitle:                 d.Get("action_keyindicator_param_title").(string),
+		ActionKeyindicatorParamSubtitle:              d.Get("action_keyindicator_param_subtitle").(string),
+		ActionKeyindicatorParamValueSuffix:           d.Get("action_keyindicator_param_value_suffix").(string),
+		ActionKeyindicatorParamInvert:                d.Get("action_keyindicator_param_invert").(string),
+		ActionKeyindicatorParamThreshold:             d.Get("action_keyindicator_param_threshold").(string),
+		ActionKeyindicatorParamDrilldownURI:          d.Get("action_keyindicator_param_drilldown_uri").(string),
+		ActionNotable:                                strings.Contains(normalizeActionsString(d.Get("actions").(string)), "notable"),
+		ActionNotableParamRuleTitle:                  d.Get("action_notable_param_rule_title").(string),
+		ActionNotableParamRuleDescription:            d.Get("action_notable_param_rule_description").(string),
+		ActionNotableParamSecurityDomain:             d.Get("action_notable_param_security_domain").(string),
+		ActionNotableParamSeverity:                   d.Get("action_notable_param_severity").(string),
+		ActionNotableParamNesFields:                  d.Get("action_notable_param_nes_fields").(string),
+		ActionTelegram:                               strings.Contains(normalizeActionsString(d.Get("actions").(string)), "telegram"),
+		ActionTelegramParamBotToken:                  d.Get("action_telegram_param_bot_token").(string),
+		ActionTelegramParamChatID:                    d.Get("action_telegram_param_chat_id").(string),
+		ActionTelegramParamMessage:                   d.Get("action_telegram_param_message").(string),
 		ActionWebhookParamUrl:                        d.Get("action_webhook_param_url").(string),
 		ActionWebhook:                                strings.Contains(normalizeActionsString(d.Get("actions").(string)), "webhook"),
 		ActionWebhookEnableAllowlist:                 d.Get("action_webhook_enable_allowlist").(int),
@@ -1725,6 +3979,7 @@ func getSavedSearchesConfig(d *schema.ResourceData) (savedSearchesObj *models.Sa
 		AlertSeverity:                                d.Get("alert_severity").(int),
 		AlertSuppress:                                d.Get("alert_suppress").(bool),
 		AlertSuppressFields:                          d.Get("alert_suppress_fields").(string),
+		AlertSuppressGroupName:                       d.Get("alert_suppress_group_name").(string),
 		AlertSuppressPeriod:                          d.Get("alert_suppress_period").(string),
 		AlertThreshold:                               d.Get("alert_threshold").(string),
 		AlertTrack:                                   d.Get("alert_track").(bool),
@@ -1762,6 +4017,7 @@ func getSavedSearchesConfig(d *schema.ResourceData) (savedSearchesObj *models.Sa
 		DispatchSpawnProcess:                         d.Get("dispatch_spawn_process").(bool),
 		DispatchTimeFormat:                           d.Get("dispatch_time_format").(string),
 		DispatchTTL:                                  d.Get("dispatch_ttl").(string),
+		DisplayGeneralColor:                          d.Get("color").(string),
 		DisplayView:                                  d.Get("display_view").(string),
 		IsScheduled:                                  d.Get("is_scheduled").(bool),
 		IsVisible:                                    d.Get("is_visible").(bool),
@@ -1777,17 +4033,351 @@ func getSavedSearchesConfig(d *schema.ResourceData) (savedSearchesObj *models.Sa
 		VSID:                                         d.Get("vsid").(string),
 		WorkloadPool:                                 d.Get("workload_pool").(string),
 	}
+
+	// Risk params are only meaningful on a risk analysis correlation search;
+	// sending them without the action enabled clutters the object.
+	if strings.Contains(normalizeActionsString(d.Get("actions").(string)), "risk") {
+		savedSearchesObj.ActionRisk = true
+		savedSearchesObj.ActionRiskParamRisk = d.Get("action_risk_param_risk").(string)
+		savedSearchesObj.ActionRiskParamRiskScore = d.Get("action_risk_param_risk_score").(string)
+		savedSearchesObj.ActionRiskParamRiskMessage = d.Get("action_risk_param_risk_message").(string)
+	}
+
+	if drilldownURL, ok := d.GetOk("action_snow_event_drilldown_url"); ok {
+		savedSearchesObj.ActionSnowEventParamAdditionalInfo = mergeSnowDrilldownURL(savedSearchesObj.ActionSnowEventParamAdditionalInfo, drilldownURL.(string))
+	}
+
+	if details, ok := d.GetOk("action_pagerduty_custom_details_map"); ok {
+		savedSearchesObj.ActionPagerdutyParamCustDetails = encodePagerdutyCustomDetails(details.(map[string]interface{}))
+	}
+
+	if baseName, ok := d.GetOk("base_search_name"); ok {
+		savedSearchesObj.Search = buildBaseSearchSPL(baseName.(string), savedSearchesObj.Search)
+	}
+
+	if pdf, ok := d.GetOk("pdf_delivery"); ok {
+		expandPDFDelivery(pdf.([]interface{})[0].(map[string]interface{}), savedSearchesObj)
+	}
+
+	if timeRange, ok := d.GetOk("time_range"); ok {
+		applyTimeRange(savedSearchesObj, timeRange.([]interface{}))
+	}
+
+	if windows, ok := d.GetOk("blackout_windows"); ok {
+		if period := suppressionFromBlackoutWindows(windows.([]interface{})); period != "" {
+			savedSearchesObj.AlertSuppress = true
+			if savedSearchesObj.AlertSuppressPeriod == "" {
+				savedSearchesObj.AlertSuppressPeriod = period
+			}
+		}
+	}
+
 	return savedSearchesObj
 }
 
+// effectivelyScheduled reports whether a search will actually run on a
+// schedule. is_scheduled alone is not enough: a disabled search or one
+// without a cron schedule never fires.
+func effectivelyScheduled(isScheduled, disabled bool, cronSchedule string) bool {
+	return isScheduled && !disabled && cronSchedule != ""
+}
+
+// systemDisabled infers whether the system, rather than a user, disabled the
+// search. Splunk exposes no disabled-by field, but a search left disabled
+// with its scheduling config intact and skipped runs on record matches the
+// auto-disable pattern; a user opting out normally clears is_scheduled.
+func systemDisabled(disabled, isScheduled bool, cronSchedule string, skippedCount int) bool {
+	return disabled && isScheduled && cronSchedule != "" && skippedCount > 0
+}
+
+// dispatchUserSearchQuota resolves the effective srchJobsQuota for the user
+// dispatching the search and their current number of running search jobs.
+// Splunk grants a user the maximum quota across the roles they hold.
+func dispatchUserSearchQuota(provider *SplunkProvider, user string) (quota, active int, err error) {
+	resp, err := (*provider.Client).ReadAuthenticationUser(user, "nobody", "system")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	userResponse := &models.AuthenticationUserResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(userResponse); err != nil {
+		return 0, 0, err
+	}
+
+	userRoles := []string{}
+	for _, entry := range userResponse.Entry {
+		if entry.Name == user {
+			userRoles = entry.Content.Roles
+		}
+	}
+
+	rolesResp, err := (*provider.Client).ReadAllAuthorizationRoles()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer rolesResp.Body.Close()
+
+	rolesResponse := &models.AuthorizationRolesResponse{}
+	if err := json.NewDecoder(rolesResp.Body).Decode(rolesResponse); err != nil {
+		return 0, 0, err
+	}
+
+	jobsResp, err := (*provider.Client).ReadUserSearchJobs(user)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer jobsResp.Body.Close()
+
+	jobsResponse := &models.SearchJobsResponse{}
+	if err := json.NewDecoder(jobsResp.Body).Decode(jobsResponse); err != nil {
+		return 0, 0, err
+	}
+
+	return effectiveSearchJobsQuota(userRoles, rolesResponse.Entry), countActiveSearchJobs(jobsResponse.Entry), nil
+}
+
+// effectiveSearchJobsQuota returns the maximum srchJobsQuota across the roles
+// the user holds, which is the concurrency limit Splunk enforces.
+func effectiveSearchJobsQuota(userRoles []string, entries []models.AuthorizationRolesEntry) int {
+	member := map[string]bool{}
+	for _, role := range userRoles {
+		member[role] = true
+	}
+
+	quota := 0
+	for _, entry := range entries {
+		if member[entry.Name] && entry.Content.SrchJobsQuota > quota {
+			quota = entry.Content.SrchJobsQuota
+		}
+	}
+	return quota
+}
+
+// countActiveSearchJobs counts the jobs still running; finished jobs linger
+// until their TTL expires but no longer consume quota.
+func countActiveSearchJobs(entries []models.SearchJobsEntry) int {
+	active := 0
+	for _, entry := range entries {
+		if !entry.Content.IsDone {
+			active++
+		}
+	}
+	return active
+}
+
+// expandPDFDelivery copies a pdf_delivery block onto the flat email PDF fields
+// of the saved search object.
+func expandPDFDelivery(block map[string]interface{}, savedSearchesObj *models.SavedSearchObject) {
+	savedSearchesObj.ActionEmailSendPDF = block["enabled"].(bool)
+	savedSearchesObj.ActionEmailPDFView = block["view"].(string)
+	savedSearchesObj.ActionEmailReportPaperSize = block["paper_size"].(string)
+	savedSearchesObj.ActionEmailReportPaperOrientation = block["orientation"].(string)
+	savedSearchesObj.ActionEmailReportIncludeSplunkLogo = block["include_logo"].(bool)
+}
+
+// flattenPDFDelivery is the inverse of expandPDFDelivery, rebuilding the block
+// from the flat fields the server returns.
+func flattenPDFDelivery(content *models.SavedSearchObject) map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":      content.ActionEmailSendPDF,
+		"view":         content.ActionEmailPDFView,
+		"paper_size":   content.ActionEmailReportPaperSize,
+		"orientation":  content.ActionEmailReportPaperOrientation,
+		"include_logo": content.ActionEmailReportIncludeSplunkLogo,
+	}
+}
+
+// buildBaseSearchSPL wraps a child search as a post-process of a base saved
+// search using the savedsearch command.
+func buildBaseSearchSPL(baseName, search string) string {
+	child := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(search), "|"))
+	return fmt.Sprintf("| savedsearch \"%s\" | %s", baseName, child)
+}
+
+// stripBaseSearchSPL undoes buildBaseSearchSPL, returning the child search and
+// whether the SPL actually carried the expected savedsearch prefix.
+func stripBaseSearchSPL(spl, baseName string) (string, bool) {
+	prefix := fmt.Sprintf("| savedsearch \"%s\" |", baseName)
+	if !strings.HasPrefix(spl, prefix) {
+		return spl, false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(spl, prefix)), true
+}
+
+// applyTimeRange copies the nested time_range block onto the flat dispatch
+// time fields of the saved search object.
+func applyTimeRange(obj *models.SavedSearchObject, timeRange []interface{}) {
+	if len(timeRange) == 0 || timeRange[0] == nil {
+		return
+	}
+	block := timeRange[0].(map[string]interface{})
+	obj.DispatchEarliestTime = block["earliest"].(string)
+	obj.DispatchLatestTime = block["latest"].(string)
+	obj.DispatchIndexEarliest = block["index_earliest"].(string)
+	obj.DispatchIndexLatest = block["index_latest"].(string)
+}
+
+// flattenTimeRange builds the nested time_range block value from the flat
+// dispatch time fields returned by Splunk.
+func flattenTimeRange(content *models.SavedSearchObject) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"earliest":       content.DispatchEarliestTime,
+		"latest":         content.DispatchLatestTime,
+		"index_earliest": content.DispatchIndexEarliest,
+		"index_latest":   content.DispatchIndexLatest,
+	}}
+}
+
+// encodePagerdutyCustomDetails serializes a custom details map to the JSON
+// blob the PagerDuty Events API v2 expects.
+func encodePagerdutyCustomDetails(details map[string]interface{}) string {
+	encoded, err := json.Marshal(details)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+// decodePagerdutyCustomDetails parses a stored custom details value back into
+// a map. It reports false when the value is not a JSON object, in which case
+// only the plain string attribute applies.
+func decodePagerdutyCustomDetails(value string) (map[string]interface{}, bool) {
+	var details map[string]string
+	if err := json.Unmarshal([]byte(value), &details); err != nil {
+		return nil, false
+	}
+	decoded := make(map[string]interface{}, len(details))
+	for k, v := range details {
+		decoded[k] = v
+	}
+	return decoded, true
+}
+
+// mergeSnowDrilldownURL merges url=<value> into the ||-separated
+// additional_info string, replacing any existing url= entry and preserving the
+// remaining key-values.
+func mergeSnowDrilldownURL(additionalInfo, drilldownURL string) string {
+	if drilldownURL == "" {
+		return additionalInfo
+	}
+	pairs := []string{"url=" + drilldownURL}
+	for _, pair := range strings.Split(additionalInfo, "||") {
+		if pair == "" || strings.HasPrefix(pair, "url=") {
+			continue
+		}
+		pairs = append(pairs, pair)
+	}
+	return strings.Join(pairs, "||")
+}
+
+// extractSnowDrilldownURL splits additional_info into the url= entry and the
+// remaining key-values.
+func extractSnowDrilldownURL(additionalInfo string) (string, string) {
+	var drilldownURL string
+	var pairs []string
+	for _, pair := range strings.Split(additionalInfo, "||") {
+		if strings.HasPrefix(pair, "url=") {
+			drilldownURL = strings.TrimPrefix(pair, "url=")
+			continue
+		}
+		if pair != "" {
+			pairs = append(pairs, pair)
+		}
+	}
+	return drilldownURL, strings.Join(pairs, "||")
+}
+
+// suppressionFromBlackoutWindows derives an alert.suppress.period from the
+// configured blackout windows. The period covers the longest window so that an
+// alert firing at the start of the window stays suppressed until it closes.
+// Windows with unparseable times are ignored.
+func suppressionFromBlackoutWindows(windows []interface{}) string {
+	var longest time.Duration
+	for _, w := range windows {
+		window := w.(map[string]interface{})
+		start, err := time.Parse("15:04", window["start"].(string))
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse("15:04", window["end"].(string))
+		if err != nil {
+			continue
+		}
+
+		duration := end.Sub(start)
+		if duration <= 0 {
+			// Window wraps past midnight
+			duration += 24 * time.Hour
+		}
+		if duration > longest {
+			longest = duration
+		}
+	}
+
+	if longest == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(longest.Minutes())) + "m"
+}
+
+// droppedActionParamKeys returns the action.<name>.param.* keys for actions that
+// are enabled in oldActions but absent from newActions.
+func droppedActionParamKeys(oldActions, newActions string) []string {
+	enabled := make(map[string]bool)
+	for _, action := range strings.Split(normalizeActionsString(newActions), ",") {
+		enabled[action] = true
+	}
+
+	var keys []string
+	for _, action := range strings.Split(normalizeActionsString(oldActions), ",") {
+		if action == "" || enabled[action] {
+			continue
+		}
+		keys = append(keys, actionParamKeys(action)...)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// actionParamKeys lists the parameter keys an action posts to the savedsearch
+// endpoint, derived from the url tags on SavedSearchObject.
+func actionParamKeys(action string) []string {
+	t := reflect.TypeOf(models.SavedSearchObject{})
+	prefix := "action." + action + ".param."
+
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("url"), ",")[0]
+		if strings.HasPrefix(tag, prefix) {
+			keys = append(keys, tag)
+		}
+	}
+	return keys
+}
+
 func getSavedSearchesConfigByName(name string, httpResponse *http.Response) (savedSearchesEntry *models.SavedSearchesEntry, err error) {
 	response := &models.SavedSearchesResponse{}
 	switch httpResponse.StatusCode {
 	case 200, 201:
-		_ = json.NewDecoder(httpResponse.Body).Decode(&response)
-		re := regexp.MustCompile(`(.*)`)
+		// A decode error (e.g. EOF on a truncated body) must not be conflated
+		// with "not found": a zero-value response here would look like a
+		// missing resource and trigger a spurious recreate.
+		body, err := io.ReadAll(httpResponse.Body)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read saved searches response: %w", err)
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("unable to decode saved searches response: %w", err)
+		}
+		// The read targets the specific name in the URL path, but Splunk may
+		// still return multiple entries (e.g. the same name in several
+		// namespaces), so require an exact match rather than a pattern.
 		for _, entry := range response.Entry {
-			if name == re.FindStringSubmatch(entry.Name)[1] {
+			if entry.Name == name {
+				logUnknownContentKeys(name, body)
+				entry.WebhookHeaders = extractWebhookHeaders(name, body)
 				return &entry, nil
 			}
 		}
@@ -1801,6 +4391,232 @@ func getSavedSearchesConfigByName(name string, httpResponse *http.Response) (sav
 	return savedSearchesEntry, nil
 }
 
+// logUnknownContentKeys logs, at debug, any content keys in the response for
+// the named search that SavedSearchObject does not model. Splunk occasionally
+// returns legacy or misspelled keys (e.g. alert.digabled) whose values would
+// otherwise be dropped silently; surfacing them aids field-coverage discovery.
+func logUnknownContentKeys(name string, body []byte) {
+	unknown := unmappedSavedSearchContentKeys(name, body)
+	if len(unknown) > 0 {
+		log.Printf("[DEBUG] Saved search %q returned content keys not modeled by the provider: %s", name, strings.Join(unknown, ", "))
+	}
+}
+
+// extractWebhookHeaders collects the action.webhook.param.http_header.* keys
+// from the response body for the named search. The header names are dynamic,
+// so they cannot live on SavedSearchObject.
+func extractWebhookHeaders(name string, body []byte) map[string]string {
+	raw := struct {
+		Entry []struct {
+			Name    string                 `json:"name"`
+			Content map[string]interface{} `json:"content"`
+		} `json:"entry"`
+	}{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil
+	}
+
+	const prefix = "action.webhook.param.http_header."
+	headers := map[string]string{}
+	for _, entry := range raw.Entry {
+		if entry.Name != name {
+			continue
+		}
+		for key, value := range entry.Content {
+			header, ok := value.(string)
+			if !ok || !strings.HasPrefix(key, prefix) {
+				continue
+			}
+			if header != "" {
+				headers[strings.TrimPrefix(key, prefix)] = header
+			}
+		}
+	}
+	return headers
+}
+
+// webhookHeaderParams maps the configured webhook headers to the dynamic
+// action.webhook.param.http_header.* keys, emitting empty values for headers
+// present in the old map but removed from the new one so the server clears
+// them.
+func webhookHeaderParams(old, new map[string]interface{}) map[string]string {
+	const prefix = "action.webhook.param.http_header."
+	params := map[string]string{}
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			params[prefix+key] = ""
+		}
+	}
+	for key, value := range new {
+		params[prefix+key] = value.(string)
+	}
+	return params
+}
+
+// applyWebhookHeaders posts the webhook header parameters for the saved
+// search, clearing any headers removed from the configuration.
+func applyWebhookHeaders(d *schema.ResourceData, provider *SplunkProvider, name, owner, app string) error {
+	old, new := d.GetChange("action_webhook_param_http_headers")
+	params := webhookHeaderParams(old.(map[string]interface{}), new.(map[string]interface{}))
+	if len(params) == 0 {
+		return nil
+	}
+	return (*provider.Client).SetSavedSearchActionParams(name, owner, app, params)
+}
+
+// unmappedSavedSearchContentKeys returns, sorted, the content keys in the
+// response body for the named search that SavedSearchObject does not model.
+func unmappedSavedSearchContentKeys(name string, body []byte) []string {
+	raw := struct {
+		Entry []struct {
+			Name    string                     `json:"name"`
+			Content map[string]json.RawMessage `json:"content"`
+		} `json:"entry"`
+	}{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil
+	}
+
+	known := knownSavedSearchContentKeys()
+	var unknown []string
+	for _, entry := range raw.Entry {
+		if entry.Name != name {
+			continue
+		}
+		for key := range entry.Content {
+			if !known[key] {
+				unknown = append(unknown, key)
+			}
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// knownSavedSearchContentKeys collects the json tags on SavedSearchObject.
+func knownSavedSearchContentKeys() map[string]bool {
+	t := reflect.TypeOf(models.SavedSearchObject{})
+	keys := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if tag != "" && tag != "-" {
+			keys[tag] = true
+		}
+	}
+	return keys
+}
+
+// resolveSavedSearchOwner returns the namespace owner to use when reading or
+// updating a saved search. Objects with shared permissions live under the
+// nobody namespace regardless of which user owns them, so using the
+// configured owner after an ACL change can 404.
+func resolveSavedSearchOwner(aclObject *models.ACLObject) string {
+	if aclObject.Sharing != "user" {
+		return "nobody"
+	}
+	return aclObject.Owner
+}
+
+// checkExternalModification re-reads the search right before an update and
+// compares the server's updated timestamp against the one captured at the
+// last read, failing when something else changed the object in between. Only
+// used when detect_external_changes is enabled.
+func checkExternalModification(provider *SplunkProvider, name, owner, app, lastUpdated string) error {
+	if lastUpdated == "" {
+		return nil
+	}
+	resp, err := (*provider.Client).ReadSavedSearches(name, owner, app)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	entry, err := getSavedSearchesConfigByName(name, resp)
+	if err != nil || entry == nil {
+		return err
+	}
+	if entry.Updated != "" && entry.Updated != lastUpdated {
+		return fmt.Errorf("saved search %q was modified on the server at %s, after the last read at %s; refresh and re-plan before updating", name, entry.Updated, lastUpdated)
+	}
+	return nil
+}
+
+// sensitiveCredentialFields maps credential attributes to the content field
+// holding the server's copy. After a write Splunk returns these encrypted or
+// masked, which is useless as state and would diff against the configured
+// plaintext on every refresh.
+func sensitiveCredentialFields(content *models.SavedSearchObject) map[string]string {
+	return map[string]string{
+		"action_email_auth_password":              content.ActionEmailAuthPassword,
+		"action_slack_param_webhook_url_override": content.ActionSlackParamWebhookUrlOverride,
+		"action_telegram_param_bot_token":         content.ActionTelegramParamBotToken,
+	}
+}
+
+// isEncryptedCredential reports whether a value is Splunk's encrypted ($1$ or
+// $7$ prefixed) or masked (all asterisks) form rather than plaintext.
+func isEncryptedCredential(value string) bool {
+	if strings.HasPrefix(value, "$1$") || strings.HasPrefix(value, "$7$") {
+		return true
+	}
+	return value != "" && strings.Trim(value, "*") == ""
+}
+
+// credentialStateValue picks the value read stores for a sensitive credential
+// field: the server's value normally, but the prior state value when the
+// server only returns the encrypted or masked form. A genuine out-of-band
+// plaintext change still comes through.
+func credentialStateValue(current, fromServer string) string {
+	if isEncryptedCredential(fromServer) && current != "" {
+		return current
+	}
+	return fromServer
+}
+
+// savedSearchACLSchema is aclSchema with app changes handled in place through
+// the object move endpoint instead of forcing a recreate.
+func savedSearchACLSchema() *schema.Schema {
+	acl := aclSchema()
+	app := acl.Elem.(*schema.Resource).Schema["app"]
+	app.ForceNew = false
+	return acl
+}
+
+// moveSavedSearchToApp relocates the search into the configured app through
+// the object move endpoint. When the endpoint is unavailable (older Splunk
+// versions) it falls back to deleting the search in the old app and
+// recreating it in the new one.
+func moveSavedSearchToApp(d *schema.ResourceData, provider *SplunkProvider, aclObject *models.ACLObject, oldApp string) error {
+	owner := resolveSavedSearchOwner(aclObject)
+	moveACL := &models.ACLObject{App: aclObject.App, Owner: aclObject.Owner}
+	err := (*provider.Client).Move(owner, oldApp, d.Id(), moveACL, "saved", "searches")
+	if err == nil {
+		return nil
+	}
+
+	log.Printf("[WARN] Move endpoint failed for saved search %q, recreating it in app %q instead: %s", d.Id(), aclObject.App, err)
+	resp, err := (*provider.Client).DeleteSavedSearches(d.Id(), owner, oldApp)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return (*provider.Client).CreateSavedSearches(d.Id(), aclObject.Owner, aclObject.App, getSavedSearchesConfig(d))
+}
+
+// resolveUpdateOwner returns the namespace owner updates should target for
+// the given owner_on_update mode. keep defers to resolveSavedSearchOwner,
+// matching the behavior before the option existed.
+func resolveUpdateOwner(mode string, aclObject *models.ACLObject) string {
+	switch mode {
+	case "nobody":
+		return "nobody"
+	case "owner":
+		return aclObject.Owner
+	default:
+		return resolveSavedSearchOwner(aclObject)
+	}
+}
+
 // getResourceDataSearchACL implements psuedo-defaults for the acl field for search resources.
 func getResourceDataSearchACL(d *schema.ResourceData) *models.ACLObject {
 	aclObject := &models.ACLObject{}
@@ -1,14 +1,25 @@
 package splunk
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"net/http/httptest"
+	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/splunk/terraform-provider-splunk/client"
+	"github.com/splunk/terraform-provider-splunk/client/models"
 )
 
 const newSavedSearches = `
@@ -24,10 +35,16 @@ resource "splunk_saved_searches" "test" {
     action_email_send_csv = 1
     action_email_send_results = 0
     action_email_allow_empty_attachment = 1
+    action_email_report_file_name = "$name$-results.csv"
     action_email_subject = "Splunk Alert: $name$"
     action_email_to = "splunk@splunk.com"
     action_email_track_alert = true
+    action_email_graceful = true
     alert_track = true
+    alert_suppress = true
+    alert_suppress_fields = "host"
+    alert_suppress_group_name = "per_host_suppression"
+    alert_suppress_period = "5m"
     dispatch_earliest_time = "rt-15m"
     dispatch_latest_time = "rt-0m"
     dispatch_index_earliest = "-10m"
@@ -46,7 +63,7 @@ resource "splunk_saved_searches" "test" {
     name = "Test New Alert"
     search = "index=main"
     actions = "email"
-    action_email_include_search = 1
+    action_email_include_search = true
     action_email_include_trigger = 1
     action_email_format = "table"
     action_email_max_time = "5m"
@@ -198,6 +215,48 @@ resource "splunk_saved_searches" "test" {
 }
 `
 
+const newSavedSearchesDiscord = `
+resource "splunk_saved_searches" "test" {
+	name = "Test Discord Alert"
+	actions = "discord"
+	action_discord_param_webhook_url = "https://discord.com/api/webhooks/123/abc"
+	action_discord_param_username = "splunk-alerts"
+	action_discord_param_message = "error message"
+	alert_comparator    = "greater than"
+	alert_digest_mode   = true
+	alert_expires       = "30d"
+	alert_threshold     = "0"
+	alert_type          = "number of events"
+	cron_schedule       = "*/1 * * * *"
+	disabled            = false
+	is_scheduled        = true
+	is_visible          = true
+	realtime_schedule   = true
+	search              = "index=main level=error"
+}
+`
+
+const newSavedSearchesTelegram = `
+resource "splunk_saved_searches" "test" {
+	name = "Test Telegram Alert"
+	actions = "telegram"
+	action_telegram_param_bot_token = "123456:bot-token"
+	action_telegram_param_chat_id = "-1000000000"
+	action_telegram_param_message = "error message"
+	alert_comparator    = "greater than"
+	alert_digest_mode   = true
+	alert_expires       = "30d"
+	alert_threshold     = "0"
+	alert_type          = "number of events"
+	cron_schedule       = "*/1 * * * *"
+	disabled            = false
+	is_scheduled        = true
+	is_visible          = true
+	realtime_schedule   = true
+	search              = "index=main level=error"
+}
+`
+
 const newSavedSearchesJiraServiceDesk = `
 resource "splunk_saved_searches" "test" {
 	name = "Test Jira Alert Ticket"
@@ -309,9 +368,15 @@ func TestAccSplunkSavedSearches(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "action_email_send_csv", "1"),
 					resource.TestCheckResourceAttr(resourceName, "action_email_send_results", "false"),
 					resource.TestCheckResourceAttr(resourceName, "action_email_allow_empty_attachment", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_report_file_name", "$name$-results.csv"),
 					resource.TestCheckResourceAttr(resourceName, "action_email_subject", "Splunk Alert: $name$"),
 					resource.TestCheckResourceAttr(resourceName, "action_email_to", "splunk@splunk.com"),
 					resource.TestCheckResourceAttr(resourceName, "action_email_track_alert", "true"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_graceful", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alert_suppress", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alert_suppress_fields", "host"),
+					resource.TestCheckResourceAttr(resourceName, "alert_suppress_group_name", "per_host_suppression"),
+					resource.TestCheckResourceAttr(resourceName, "alert_suppress_period", "5m"),
 					resource.TestCheckResourceAttr(resourceName, "alert_track", "true"),
 					resource.TestCheckResourceAttr(resourceName, "dispatch_earliest_time", "rt-15m"),
 					resource.TestCheckResourceAttr(resourceName, "dispatch_latest_time", "rt-0m"),
@@ -458,6 +523,26 @@ func TestAccSplunkSavedSearches(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "action_pagerduty_integration_key_override", "qrst"),
 				),
 			},
+			{
+				Config: newSavedSearchesDiscord,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Test Discord Alert"),
+					resource.TestCheckResourceAttr(resourceName, "actions", "discord"),
+					resource.TestCheckResourceAttr(resourceName, "action_discord_param_webhook_url", "https://discord.com/api/webhooks/123/abc"),
+					resource.TestCheckResourceAttr(resourceName, "action_discord_param_username", "splunk-alerts"),
+					resource.TestCheckResourceAttr(resourceName, "action_discord_param_message", "error message"),
+				),
+			},
+			{
+				Config: newSavedSearchesTelegram,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Test Telegram Alert"),
+					resource.TestCheckResourceAttr(resourceName, "actions", "telegram"),
+					resource.TestCheckResourceAttr(resourceName, "action_telegram_param_bot_token", "123456:bot-token"),
+					resource.TestCheckResourceAttr(resourceName, "action_telegram_param_chat_id", "-1000000000"),
+					resource.TestCheckResourceAttr(resourceName, "action_telegram_param_message", "error message"),
+				),
+			},
 			{
 				Config: newSavedSearchesJiraServiceDesk,
 				Check: resource.ComposeTestCheckFunc(
@@ -553,6 +638,78 @@ func TestAccSplunkSavedSearches(t *testing.T) {
 	})
 }
 
+const newSavedSearchesACLClone = `
+resource "splunk_saved_searches" "source" {
+    name = "Test ACL Source Alert"
+    search = "index=main"
+    acl {
+      owner = "admin"
+      sharing = "app"
+      app = "search"
+    }
+}
+
+resource "splunk_saved_searches" "clone" {
+    name = "Test ACL Clone Alert"
+    search = "index=main"
+    acl_from = splunk_saved_searches.source.name
+}
+`
+
+const newSavedSearchesMultiApp = `
+resource "splunk_saved_searches" "apps" {
+    for_each = toset(["search", "launcher"])
+    name = "Test Multi App Alert"
+    search = "index=main"
+    acl {
+      owner = "admin"
+      sharing = "app"
+      app = each.value
+    }
+}
+`
+
+func TestAccSplunkSavedSearchesMultiApp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSplunkSavedSearchesDestroyResources,
+		Steps: []resource.TestStep{
+			{
+				Config: newSavedSearchesMultiApp,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(`splunk_saved_searches.apps["search"]`, "acl.0.app", "search"),
+					resource.TestCheckResourceAttr(`splunk_saved_searches.apps["launcher"]`, "acl.0.app", "launcher"),
+					resource.TestCheckResourceAttr(`splunk_saved_searches.apps["search"]`, "name", "Test Multi App Alert"),
+					resource.TestCheckResourceAttr(`splunk_saved_searches.apps["launcher"]`, "name", "Test Multi App Alert"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSplunkSavedSearchesACLClone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSplunkSavedSearchesDestroyResources,
+		Steps: []resource.TestStep{
+			{
+				Config: newSavedSearchesACLClone,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("splunk_saved_searches.clone", "name", "Test ACL Clone Alert"),
+					resource.TestCheckResourceAttr("splunk_saved_searches.clone", "acl.0.sharing", "app"),
+					resource.TestCheckResourceAttr("splunk_saved_searches.clone", "acl.0.app", "search"),
+				),
+			},
+		},
+	})
+}
+
 func testAccSplunkSavedSearchesDestroyResources(s *terraform.State) error {
 	client, err := newTestClient()
 	if err != nil {
@@ -596,3 +753,1796 @@ func TestResourceExampleInstanceStateUpgradeV0(t *testing.T) {
 		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
 	}
 }
+
+func TestDroppedActionParamKeys(t *testing.T) {
+	keys := droppedActionParamKeys("email,slack", "email,webhook")
+	expected := []string{
+		"action.slack.param.attachment",
+		"action.slack.param.channel",
+		"action.slack.param.fields",
+		"action.slack.param.message",
+		"action.slack.param.webhook_url_override",
+	}
+	if !reflect.DeepEqual(expected, keys) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, keys)
+	}
+
+	if keys := droppedActionParamKeys("email,slack", "slack,email"); keys != nil {
+		t.Fatalf("expected no dropped keys, got: %#v", keys)
+	}
+}
+
+func TestSuppressionFromBlackoutWindows(t *testing.T) {
+	windows := []interface{}{
+		map[string]interface{}{"start": "01:00", "end": "03:00"},
+		map[string]interface{}{"start": "22:00", "end": "23:30"},
+	}
+	if period := suppressionFromBlackoutWindows(windows); period != "120m" {
+		t.Fatalf("expected period 120m, got: %v", period)
+	}
+
+	// Window wrapping past midnight
+	windows = []interface{}{
+		map[string]interface{}{"start": "23:00", "end": "01:00"},
+	}
+	if period := suppressionFromBlackoutWindows(windows); period != "120m" {
+		t.Fatalf("expected period 120m, got: %v", period)
+	}
+
+	// Unparseable windows are ignored
+	windows = []interface{}{
+		map[string]interface{}{"start": "not-a-time", "end": "01:00"},
+	}
+	if period := suppressionFromBlackoutWindows(windows); period != "" {
+		t.Fatalf("expected no period, got: %v", period)
+	}
+}
+
+func TestGetSavedSearchesConfigByNameTruncatedResponse(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(`{"entry":[{"name":"Test`)),
+	}
+	entry, err := getSavedSearchesConfigByName("Test", resp)
+	if err == nil {
+		t.Fatal("expected a decode error for a truncated response, got nil")
+	}
+	if entry != nil {
+		t.Fatalf("expected no entry for a truncated response, got: %#v", entry)
+	}
+}
+
+func TestGetSavedSearchesConfigByNameExactMatch(t *testing.T) {
+	body := `{"entry":[{"name":"Test Search Extended","content":{"search":"index=other"}},` +
+		`{"name":"Test Search","content":{"search":"index=main"}}]}`
+	resp := &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+	entry, err := getSavedSearchesConfigByName("Test Search", resp)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if entry == nil || entry.Name != "Test Search" {
+		t.Fatalf("expected the exact-name entry, got: %#v", entry)
+	}
+	if entry.Content.Search != "index=main" {
+		t.Fatalf("expected the exact-name entry's content, got: %#v", entry.Content.Search)
+	}
+}
+
+func TestEffectiveSearchJobsQuota(t *testing.T) {
+	entries := []models.AuthorizationRolesEntry{
+		{Name: "user", Content: models.AuthorizationRolesObject{SrchJobsQuota: 3}},
+		{Name: "power", Content: models.AuthorizationRolesObject{SrchJobsQuota: 10}},
+		{Name: "admin", Content: models.AuthorizationRolesObject{SrchJobsQuota: 50}},
+	}
+	if quota := effectiveSearchJobsQuota([]string{"user", "power"}, entries); quota != 10 {
+		t.Fatalf("expected the maximum quota across held roles (10), got: %d", quota)
+	}
+	if quota := effectiveSearchJobsQuota([]string{"unknown"}, entries); quota != 0 {
+		t.Fatalf("expected zero quota for unknown roles, got: %d", quota)
+	}
+}
+
+func TestCountActiveSearchJobs(t *testing.T) {
+	entries := []models.SearchJobsEntry{
+		{Name: "sid1", Content: models.SearchJobObject{IsDone: false}},
+		{Name: "sid2", Content: models.SearchJobObject{IsDone: true}},
+		{Name: "sid3", Content: models.SearchJobObject{IsDone: false}},
+	}
+	if active := countActiveSearchJobs(entries); active != 2 {
+		t.Fatalf("expected 2 active jobs, got: %d", active)
+	}
+}
+
+func TestSuppressDefaultWorkloadPoolDiff(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, savedSearches().Schema, map[string]interface{}{
+		"default_workload_pool": "standard_perf",
+	})
+	if !suppressDefaultWorkloadPoolDiff("workload_pool", "standard_perf", "", d) {
+		t.Error("expected a cleared pool matching the default pool to be suppressed")
+	}
+	if suppressDefaultWorkloadPoolDiff("workload_pool", "high_perf", "", d) {
+		t.Error("expected a cleared pool differing from the default pool to diff")
+	}
+	if suppressDefaultWorkloadPoolDiff("workload_pool", "standard_perf", "high_perf", d) {
+		t.Error("expected an explicitly configured pool to diff")
+	}
+
+	d = schema.TestResourceDataRaw(t, savedSearches().Schema, map[string]interface{}{})
+	if suppressDefaultWorkloadPoolDiff("workload_pool", "standard_perf", "", d) {
+		t.Error("expected no suppression when default_workload_pool is not configured")
+	}
+}
+
+func TestSuppressServerAddedDefault(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, savedSearches().Schema, map[string]interface{}{})
+	if !suppressServerAddedDefault("action_email_format", "table", "", d) {
+		t.Error("expected the built-in email format default to be suppressed")
+	}
+	if !suppressServerAddedDefault("action_discord_param_username", "Splunk", "", d) {
+		t.Error("expected the built-in discord username default to be suppressed")
+	}
+	if suppressServerAddedDefault("action_email_format", "html", "", d) {
+		t.Error("expected a non-default server value to diff")
+	}
+	if suppressServerAddedDefault("action_email_format", "table", "table", d) {
+		t.Error("expected an explicitly configured value to diff")
+	}
+	if suppressServerAddedDefault("action_email_subject", "Splunk Alert: $name$", "", d) {
+		t.Error("expected an attribute without a known default to diff")
+	}
+
+	d = schema.TestResourceDataRaw(t, savedSearches().Schema, map[string]interface{}{
+		"server_default_action_params": map[string]interface{}{
+			"action_email_format":  "html",
+			"action_email_subject": "Splunk Alert: $name$",
+		},
+	})
+	if !suppressServerAddedDefault("action_email_format", "html", "", d) {
+		t.Error("expected the overridden email format default to be suppressed")
+	}
+	if suppressServerAddedDefault("action_email_format", "table", "", d) {
+		t.Error("expected the built-in default to diff once overridden")
+	}
+	if !suppressServerAddedDefault("action_email_subject", "Splunk Alert: $name$", "", d) {
+		t.Error("expected a user-added default to be suppressed")
+	}
+}
+
+func TestResolveScheduleWindow(t *testing.T) {
+	if window := resolveScheduleWindow("15", "*/5 * * * *"); window != "15" {
+		t.Fatalf("expected a fixed window to pass through, got: %q", window)
+	}
+	if window := resolveScheduleWindow("auto", "*/30 * * * *"); window != "30" {
+		t.Fatalf("expected auto to resolve to the 30m period, got: %q", window)
+	}
+	if window := resolveScheduleWindow("auto", "17 3 * * 2"); window != "auto" {
+		t.Fatalf("expected auto to stay auto for an indeterminate period, got: %q", window)
+	}
+}
+
+func TestJoinLookupRecipients(t *testing.T) {
+	results := []map[string]interface{}{
+		{"email": "ops@example.com", "name": "Ops"},
+		{"email": "dev@example.com"},
+		{"email": " ops@example.com "},
+		{"email": ""},
+		{"name": "no email column"},
+	}
+	if got, want := joinLookupRecipients(results, "email"), "dev@example.com,ops@example.com"; got != want {
+		t.Fatalf("expected %q, got: %q", want, got)
+	}
+	if got := joinLookupRecipients(results, "missing"); got != "" {
+		t.Fatalf("expected an empty list for a missing column, got: %q", got)
+	}
+}
+
+func TestSummarizeActions(t *testing.T) {
+	content := &models.SavedSearchObject{
+		Actions:                 "slack, email",
+		ActionEmailTo:           "ops@example.com",
+		ActionSlackParamChannel: "#alerts",
+	}
+	if got, want := summarizeActions(content), "email(to=ops@example.com), slack(channel=#alerts)"; got != want {
+		t.Fatalf("expected %q, got: %q", want, got)
+	}
+
+	content = &models.SavedSearchObject{Actions: "pagerduty"}
+	if got, want := summarizeActions(content), "pagerduty"; got != want {
+		t.Fatalf("expected %q, got: %q", want, got)
+	}
+
+	if got := summarizeActions(&models.SavedSearchObject{}); got != "" {
+		t.Fatalf("expected an empty summary with no actions, got: %q", got)
+	}
+}
+
+func TestRequireSeverityPrecisionPair(t *testing.T) {
+	if err := requireSeverityPrecisionPair("high", "strong"); err != nil {
+		t.Fatalf("expected both set to be valid, got: %v", err)
+	}
+	if err := requireSeverityPrecisionPair("", ""); err != nil {
+		t.Fatalf("expected neither set to be valid, got: %v", err)
+	}
+	if err := requireSeverityPrecisionPair("high", ""); err == nil {
+		t.Fatal("expected an error when only severity is set")
+	}
+	if err := requireSeverityPrecisionPair("", "strong"); err == nil {
+		t.Fatal("expected an error when only precision is set")
+	}
+}
+
+func TestCheckSearchParses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(body), "unbalanced") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"messages":[{"type":"FATAL","text":"Unbalanced quotes."}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"messages":[]}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u := strings.TrimPrefix(ts.URL, "http://")
+	c, err := client.NewSplunkdClient("", [2]string{"admin", "changeme"}, u, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := &SplunkProvider{Client: c}
+
+	if err := checkSearchParses(provider, "index=main | stats count"); err != nil {
+		t.Fatalf("expected a valid search to parse, got: %v", err)
+	}
+
+	err = checkSearchParses(provider, `index=main "unbalanced`)
+	if err == nil {
+		t.Fatal("expected a parse error for unbalanced quotes")
+	}
+	if !strings.Contains(err.Error(), "Unbalanced quotes.") {
+		t.Fatalf("expected the server's message in the error, got: %v", err)
+	}
+}
+
+func TestMoveSavedSearchToApp(t *testing.T) {
+	moveSupported := true
+	moved, deleted, created := false, false, false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/move"):
+			if !moveSupported {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"messages":[{"type":"ERROR","text":"Action not found"}]}`))
+				return
+			}
+			moved = true
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodDelete:
+			deleted = true
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			created = true
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u := strings.TrimPrefix(ts.URL, "http://")
+	c, err := client.NewSplunkdClient("", [2]string{"admin", "changeme"}, u, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := &SplunkProvider{Client: c}
+
+	d := schema.TestResourceDataRaw(t, savedSearches().Schema, map[string]interface{}{
+		"search": "index=main | stats count",
+	})
+	d.SetId("Test Search")
+	aclObject := &models.ACLObject{App: "reporting", Owner: "admin", Sharing: "user"}
+
+	if err := moveSavedSearchToApp(d, provider, aclObject, "search"); err != nil {
+		t.Fatalf("expected the move to succeed, got: %v", err)
+	}
+	if !moved || deleted || created {
+		t.Errorf("expected only the move endpoint to be hit, got moved=%t deleted=%t created=%t", moved, deleted, created)
+	}
+
+	moveSupported, moved = false, false
+	if err := moveSavedSearchToApp(d, provider, aclObject, "search"); err != nil {
+		t.Fatalf("expected the recreate fallback to succeed, got: %v", err)
+	}
+	if moved || !deleted || !created {
+		t.Errorf("expected delete and create when move is unsupported, got moved=%t deleted=%t created=%t", moved, deleted, created)
+	}
+}
+
+func TestSavedSearchACLAppMovesInPlace(t *testing.T) {
+	app := savedSearchACLSchema().Elem.(*schema.Resource).Schema["app"]
+	if app.ForceNew {
+		t.Error("expected acl.app changes on saved searches to update in place via the move endpoint")
+	}
+	shared := aclSchema().Elem.(*schema.Resource).Schema["app"]
+	if !shared.ForceNew {
+		t.Error("expected the shared acl schema to keep forcing new on app changes")
+	}
+}
+
+func TestCheckExternalModification(t *testing.T) {
+	updated := "2026-08-26T10:00:00+00:00"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"entry":[{"name":"Test Search","updated":"` + updated + `","content":{"search":"index=main"}}]}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u := strings.TrimPrefix(ts.URL, "http://")
+	c, err := client.NewSplunkdClient("", [2]string{"admin", "changeme"}, u, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := &SplunkProvider{Client: c}
+
+	if err := checkExternalModification(provider, "Test Search", "admin", "search", updated); err != nil {
+		t.Errorf("expected no error when the timestamp is unchanged, got: %v", err)
+	}
+	if err := checkExternalModification(provider, "Test Search", "admin", "search", ""); err != nil {
+		t.Errorf("expected no error without a captured timestamp, got: %v", err)
+	}
+
+	err = checkExternalModification(provider, "Test Search", "admin", "search", "2026-08-26T09:00:00+00:00")
+	if err == nil {
+		t.Fatal("expected an error when the search changed since the last read")
+	}
+	if !strings.Contains(err.Error(), "modified on the server") {
+		t.Errorf("expected an external-modification error, got: %v", err)
+	}
+}
+
+func TestCredentialStateValue(t *testing.T) {
+	if got := credentialStateValue("hunter2", "$7$Zm9vYmFyYmF6cXV4"); got != "hunter2" {
+		t.Errorf("expected the configured value to be kept for an encrypted read-back, got %q", got)
+	}
+	if got := credentialStateValue("hunter2", "$1$aGVsbG8="); got != "hunter2" {
+		t.Errorf("expected the configured value to be kept for a $1$ read-back, got %q", got)
+	}
+	if got := credentialStateValue("hunter2", "********"); got != "hunter2" {
+		t.Errorf("expected the configured value to be kept for a masked read-back, got %q", got)
+	}
+	if got := credentialStateValue("hunter2", "changed-elsewhere"); got != "changed-elsewhere" {
+		t.Errorf("expected a plaintext server value to win, got %q", got)
+	}
+	if got := credentialStateValue("hunter2", ""); got != "" {
+		t.Errorf("expected a cleared server value to win, got %q", got)
+	}
+	if got := credentialStateValue("", "$7$Zm9vYmFyYmF6cXV4"); got != "$7$Zm9vYmFyYmF6cXV4" {
+		t.Errorf("expected the encrypted value to be stored when nothing is configured, got %q", got)
+	}
+}
+
+func TestSystemDisabled(t *testing.T) {
+	if !systemDisabled(true, true, "*/5 * * * *", 3) {
+		t.Error("expected a disabled search with intact scheduling and skips to look system-disabled")
+	}
+	if systemDisabled(true, false, "*/5 * * * *", 3) {
+		t.Error("expected an unscheduled disabled search to look user-disabled")
+	}
+	if systemDisabled(true, true, "*/5 * * * *", 0) {
+		t.Error("expected a disabled search without skipped runs to look user-disabled")
+	}
+	if systemDisabled(false, true, "*/5 * * * *", 3) {
+		t.Error("expected an enabled search to not look system-disabled")
+	}
+}
+
+func TestExtractWebhookHeaders(t *testing.T) {
+	body := []byte(`{"entry":[{"name":"Test Search","content":{` +
+		`"action.webhook.param.url":"https://example.com/hook",` +
+		`"action.webhook.param.http_header.Authorization":"Bearer token",` +
+		`"action.webhook.param.http_header.X-Env":"prod",` +
+		`"action.webhook.param.http_header.X-Cleared":"",` +
+		`"cron_schedule":"*/5 * * * *"}},` +
+		`{"name":"Other Search","content":{"action.webhook.param.http_header.X-Other":"nope"}}]}`)
+
+	headers := extractWebhookHeaders("Test Search", body)
+	expected := map[string]string{
+		"Authorization": "Bearer token",
+		"X-Env":         "prod",
+	}
+	if len(headers) != len(expected) {
+		t.Fatalf("expected %d headers, got %#v", len(expected), headers)
+	}
+	for key, value := range expected {
+		if headers[key] != value {
+			t.Errorf("expected header %q to be %q, got %q", key, value, headers[key])
+		}
+	}
+}
+
+func TestWebhookHeaderParams(t *testing.T) {
+	old := map[string]interface{}{
+		"Authorization": "Bearer old",
+		"X-Removed":     "gone",
+	}
+	new := map[string]interface{}{
+		"Authorization": "Bearer new",
+		"X-Added":       "fresh",
+	}
+
+	params := webhookHeaderParams(old, new)
+	expected := map[string]string{
+		"action.webhook.param.http_header.Authorization": "Bearer new",
+		"action.webhook.param.http_header.X-Added":       "fresh",
+		"action.webhook.param.http_header.X-Removed":     "",
+	}
+	if len(params) != len(expected) {
+		t.Fatalf("expected %d params, got %#v", len(expected), params)
+	}
+	for key, value := range expected {
+		got, ok := params[key]
+		if !ok || got != value {
+			t.Errorf("expected param %q to be %q, got %q", key, value, got)
+		}
+	}
+
+	if params := webhookHeaderParams(map[string]interface{}{}, map[string]interface{}{}); len(params) != 0 {
+		t.Errorf("expected no params for empty maps, got %#v", params)
+	}
+}
+
+func TestValidateRiskObjectsJSON(t *testing.T) {
+	valid := []string{
+		"",
+		`[{"risk_object_field":"user","risk_object_type":"user","risk_score":60}]`,
+		`[{"risk_object_field":"dest","risk_object_type":"system","risk_score":40},{"risk_object_field":"user","risk_object_type":"user","risk_score":80}]`,
+	}
+	for _, value := range valid {
+		if _, errors := validateRiskObjectsJSON(value, "action_risk_param_risk"); len(errors) > 0 {
+			t.Errorf("expected %q to validate, got: %v", value, errors)
+		}
+	}
+
+	invalid := []string{
+		`{"risk_object_field":"user"}`,
+		`[{"risk_object_field":"user"`,
+		`not json`,
+	}
+	for _, value := range invalid {
+		if _, errors := validateRiskObjectsJSON(value, "action_risk_param_risk"); len(errors) == 0 {
+			t.Errorf("expected %q to be rejected", value)
+		}
+	}
+}
+
+func TestResolveUpdateOwner(t *testing.T) {
+	shared := &models.ACLObject{Owner: "alice", Sharing: "app"}
+	private := &models.ACLObject{Owner: "alice", Sharing: "user"}
+
+	if owner := resolveUpdateOwner("keep", shared); owner != "nobody" {
+		t.Errorf("expected keep to resolve a shared search to nobody, got %q", owner)
+	}
+	if owner := resolveUpdateOwner("keep", private); owner != "alice" {
+		t.Errorf("expected keep to resolve a private search to its owner, got %q", owner)
+	}
+	if owner := resolveUpdateOwner("nobody", private); owner != "nobody" {
+		t.Errorf("expected nobody mode to always use nobody, got %q", owner)
+	}
+	if owner := resolveUpdateOwner("owner", shared); owner != "alice" {
+		t.Errorf("expected owner mode to keep the configured owner, got %q", owner)
+	}
+}
+
+func TestValidateLocale(t *testing.T) {
+	for _, valid := range []string{"en", "en-US", "ja", "zh-Hant", "de-DE-1996"} {
+		if _, errs := validateLocale(valid, "action_email_locale"); len(errs) > 0 {
+			t.Errorf("expected %q to be a valid locale, got: %v", valid, errs)
+		}
+	}
+	for _, invalid := range []string{"e", "english", "en_US", "-US"} {
+		if _, errs := validateLocale(invalid, "action_email_locale"); len(errs) == 0 {
+			t.Errorf("expected %q to be an invalid locale", invalid)
+		}
+	}
+}
+
+func TestCheckWebhookReachability(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	if err := checkWebhookReachability(ts.URL); err != nil {
+		t.Errorf("expected %q to be reachable, got: %v", ts.URL, err)
+	}
+
+	// Closing the server leaves the URL pointing at a dead listener.
+	unreachable := ts.URL
+	ts.Close()
+	if err := checkWebhookReachability(unreachable); err == nil {
+		t.Errorf("expected %q to be unreachable", unreachable)
+	}
+}
+
+func TestLogUnknownContentKeys(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	body := []byte(`{"entry":[{"name":"Test Alert","content":{"search":"index=main","alert.digabled":"1"}}]}`)
+	logUnknownContentKeys("Test Alert", body)
+
+	if !strings.Contains(buf.String(), "alert.digabled") {
+		t.Errorf("expected a debug log mentioning alert.digabled, got: %q", buf.String())
+	}
+	if !strings.Contains(buf.String(), "[DEBUG]") {
+		t.Errorf("expected the log to be at debug level, got: %q", buf.String())
+	}
+
+	buf.Reset()
+	logUnknownContentKeys("Test Alert", []byte(`{"entry":[{"name":"Test Alert","content":{"search":"index=main"}}]}`))
+	if buf.Len() > 0 {
+		t.Errorf("expected no log for fully modeled content, got: %q", buf.String())
+	}
+}
+
+func TestScheduleWindowPeriodWarning(t *testing.T) {
+	if warning := scheduleWindowPeriodWarning("60", "*/5 * * * *"); warning == "" {
+		t.Error("expected a warning for a window larger than the period")
+	}
+	if warning := scheduleWindowPeriodWarning("5", "*/5 * * * *"); warning == "" {
+		t.Error("expected a warning for a window equal to the period")
+	}
+	if warning := scheduleWindowPeriodWarning("4", "*/5 * * * *"); warning != "" {
+		t.Errorf("expected no warning for a window smaller than the period, got: %q", warning)
+	}
+	if warning := scheduleWindowPeriodWarning("auto", "*/5 * * * *"); warning != "" {
+		t.Errorf("expected no warning for an auto window, got: %q", warning)
+	}
+	if warning := scheduleWindowPeriodWarning("60", "17 3 * * 2"); warning != "" {
+		t.Errorf("expected no warning when the period is indeterminate, got: %q", warning)
+	}
+}
+
+func TestValidateScheduleWindow(t *testing.T) {
+	for _, valid := range []string{"", "0", "5", "auto"} {
+		if _, errs := validateScheduleWindow(valid, "schedule_window"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got: %v", valid, errs)
+		}
+	}
+	for _, invalid := range []string{"-1", "5m", "automatic"} {
+		if _, errs := validateScheduleWindow(invalid, "schedule_window"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", invalid)
+		}
+	}
+}
+
+func TestCronPeriodMinutes(t *testing.T) {
+	cases := map[string]int{
+		"*/5 * * * *":  5,
+		"* * * * *":    1,
+		"0 * * * *":    60,
+		"30 */2 * * *": 120,
+		"0 0 * * *":    0,
+		"not a cron":   0,
+	}
+	for cron, want := range cases {
+		if got := cronPeriodMinutes(cron); got != want {
+			t.Errorf("cronPeriodMinutes(%q) = %d, want %d", cron, got, want)
+		}
+	}
+}
+
+func TestResolveSavedSearchOwner(t *testing.T) {
+	cases := map[string]string{
+		"user":   "admin",
+		"app":    "nobody",
+		"global": "nobody",
+		"":       "nobody",
+	}
+	for sharing, want := range cases {
+		aclObject := &models.ACLObject{Owner: "admin", Sharing: sharing}
+		if got := resolveSavedSearchOwner(aclObject); got != want {
+			t.Errorf("resolveSavedSearchOwner(sharing=%q) = %q, want %q", sharing, got, want)
+		}
+	}
+}
+
+func TestParseSearchDependencies(t *testing.T) {
+	search := "index=main `security_indexes` | lookup asset_lookup ip OUTPUT owner " +
+		"| inputlookup append=t blocked_ips | datamodel Network_Traffic search " +
+		"| tstats count from datamodel=Authentication | savedsearch \"Base Search\""
+
+	deps := parseSearchDependencies(search)
+
+	if want := []string{"asset_lookup", "blocked_ips"}; !reflect.DeepEqual(deps["lookups"], want) {
+		t.Errorf("lookups invalid, got %v, want %v", deps["lookups"], want)
+	}
+	if want := []string{"security_indexes"}; !reflect.DeepEqual(deps["macros"], want) {
+		t.Errorf("macros invalid, got %v, want %v", deps["macros"], want)
+	}
+	if want := []string{"Authentication", "Network_Traffic"}; !reflect.DeepEqual(deps["data_models"], want) {
+		t.Errorf("data_models invalid, got %v, want %v", deps["data_models"], want)
+	}
+	if want := []string{"Base Search"}; !reflect.DeepEqual(deps["saved_searches"], want) {
+		t.Errorf("saved_searches invalid, got %v, want %v", deps["saved_searches"], want)
+	}
+
+	if deps := parseSearchDependencies("index=main | stats count"); len(deps["lookups"].([]string)) != 0 {
+		t.Errorf("expected no lookups, got %v", deps["lookups"])
+	}
+}
+
+func TestExceedsAttachmentThreshold(t *testing.T) {
+	if !exceedsAttachmentThreshold(true, 20000, 10000) {
+		t.Error("expected the guard to fire above the threshold")
+	}
+	if exceedsAttachmentThreshold(true, 5000, 10000) {
+		t.Error("expected no warning below the threshold")
+	}
+	if exceedsAttachmentThreshold(false, 20000, 10000) {
+		t.Error("expected no warning when results are not attached")
+	}
+	if exceedsAttachmentThreshold(true, 20000, 0) {
+		t.Error("expected a zero threshold to disable the guard")
+	}
+}
+
+func TestWebhookPriorityName(t *testing.T) {
+	cases := map[int]string{
+		4: "critical",
+		3: "high",
+		2: "medium",
+		1: "low",
+		0: "",
+		5: "",
+	}
+	for priority, want := range cases {
+		if got := webhookPriorityName(priority); got != want {
+			t.Errorf("webhookPriorityName(%d) = %q, want %q", priority, got, want)
+		}
+	}
+	for name, priority := range webhookPriorityNames {
+		if got := webhookPriorityName(priority); got != name {
+			t.Errorf("round-trip for %q failed, got %q", name, got)
+		}
+	}
+}
+
+func TestValidateSnowKeyValueFormat(t *testing.T) {
+	for _, valid := range []string{"", "k1=v1", "k1=v1||k2=v2", "url=https://splunk.example.com||k1=v1"} {
+		if _, errs := validateSnowKeyValueFormat(valid, "action_snow_event_param_ci_identifier"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got: %v", valid, errs)
+		}
+	}
+	for _, invalid := range []string{"k1", "k1=v1||k2", "=v1", "k1=v1||=v2"} {
+		if _, errs := validateSnowKeyValueFormat(invalid, "action_snow_event_param_ci_identifier"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", invalid)
+		}
+	}
+}
+
+func TestSnowDrilldownURLRoundTrip(t *testing.T) {
+	merged := mergeSnowDrilldownURL("k1=v1||k2=v2", "https://splunk.example.com/app/search")
+	if want := "url=https://splunk.example.com/app/search||k1=v1||k2=v2"; merged != want {
+		t.Fatalf("merged additional_info invalid, got %q, want %q", merged, want)
+	}
+
+	drilldownURL, rest := extractSnowDrilldownURL(merged)
+	if want := "https://splunk.example.com/app/search"; drilldownURL != want {
+		t.Errorf("extracted url invalid, got %q, want %q", drilldownURL, want)
+	}
+	if want := "k1=v1||k2=v2"; rest != want {
+		t.Errorf("remaining key-values invalid, got %q, want %q", rest, want)
+	}
+
+	// Merging replaces an existing url= entry rather than duplicating it
+	merged = mergeSnowDrilldownURL(merged, "https://other.example.com")
+	if want := "url=https://other.example.com||k1=v1||k2=v2"; merged != want {
+		t.Errorf("re-merged additional_info invalid, got %q, want %q", merged, want)
+	}
+}
+
+func TestCronFieldsEquivalent(t *testing.T) {
+	equivalent := [][2]string{
+		{"*/10 * * * *", "0,10,20,30,40,50 * * * *"},
+		{"0 0-23/2 * * *", "0 0,2,4,6,8,10,12,14,16,18,20,22 * * *"},
+		{"5 * * * *", "5 * * * *"},
+		{"*/15 * * * 1-5", "0,15,30,45 * * * 1,2,3,4,5"},
+	}
+	for _, pair := range equivalent {
+		if !cronFieldsEquivalent(pair[0], pair[1]) {
+			t.Errorf("expected %q and %q to be equivalent", pair[0], pair[1])
+		}
+	}
+
+	different := [][2]string{
+		{"*/10 * * * *", "*/15 * * * *"},
+		{"0 * * * *", "0 0 * * *"},
+		{"*/10 * * * *", "not a cron"},
+	}
+	for _, pair := range different {
+		if cronFieldsEquivalent(pair[0], pair[1]) {
+			t.Errorf("expected %q and %q to differ", pair[0], pair[1])
+		}
+	}
+}
+
+func TestIsMaskedPassword(t *testing.T) {
+	for _, masked := range []string{"********", "****", "$1$n6g0W7F51oPb", "$7$C5Vi3HLLWLnTkM"} {
+		if !isMaskedPassword(masked) {
+			t.Errorf("expected %q to be treated as masked", masked)
+		}
+	}
+	for _, clear := range []string{"", "changeme", "p*ssword", "1$notmasked"} {
+		if isMaskedPassword(clear) {
+			t.Errorf("expected %q to be treated as clear text", clear)
+		}
+	}
+}
+
+func TestValidateAutoSummarizeCommand(t *testing.T) {
+	for _, valid := range []string{
+		"",
+		"summarize override=partial timespan=$auto_summarize.timespan$ [ $search$ ]",
+		"sistats count by host",
+	} {
+		if _, errs := validateAutoSummarizeCommand(valid, "auto_summarize_command"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got: %v", valid, errs)
+		}
+	}
+	if _, errs := validateAutoSummarizeCommand("stats count by host", "auto_summarize_command"); len(errs) == 0 {
+		t.Error("expected template without a summarization directive to be invalid")
+	}
+}
+
+func TestUnmappedSavedSearchContentKeys(t *testing.T) {
+	body := []byte(`{"entry": [{"name": "test", "content": {"search": "index=main", "alert.digabled": "0", "workload_rule": "r1"}}]}`)
+
+	unmapped := unmappedSavedSearchContentKeys("test", body)
+	if want := []string{"alert.digabled", "workload_rule"}; !reflect.DeepEqual(unmapped, want) {
+		t.Errorf("unmapped keys invalid, got %v, want %v", unmapped, want)
+	}
+
+	if unmapped := unmappedSavedSearchContentKeys("other", body); len(unmapped) != 0 {
+		t.Errorf("expected no unmapped keys for unmatched search, got %v", unmapped)
+	}
+}
+
+func TestPagerdutyCustomDetailsRoundTrip(t *testing.T) {
+	encoded := encodePagerdutyCustomDetails(map[string]interface{}{"env": "prod", "team": "ops"})
+
+	details, ok := decodePagerdutyCustomDetails(encoded)
+	if !ok {
+		t.Fatalf("expected %q to decode as a custom details map", encoded)
+	}
+	if want := map[string]interface{}{"env": "prod", "team": "ops"}; !reflect.DeepEqual(details, want) {
+		t.Errorf("decoded custom details invalid, got %v, want %v", details, want)
+	}
+
+	// Plain strings keep flowing through the legacy string attribute
+	if _, ok := decodePagerdutyCustomDetails("ijkl"); ok {
+		t.Error("expected a non-JSON value not to decode as a custom details map")
+	}
+}
+
+func TestIsTransformingSearch(t *testing.T) {
+	for _, transforming := range []string{
+		"index=main | stats count by host",
+		"index=main level=error | timechart span=1h count",
+		"| tstats count where index=main by sourcetype",
+		"index=main | eval foo=1 | chart count over host by sourcetype",
+	} {
+		if !isTransformingSearch(transforming) {
+			t.Errorf("expected %q to be transforming", transforming)
+		}
+	}
+	for _, streaming := range []string{
+		"index=main level=error",
+		"index=main | eval foo=1 | table host, foo",
+		"index=main | where host=\"stats\"",
+	} {
+		if isTransformingSearch(streaming) {
+			t.Errorf("expected %q not to be transforming", streaming)
+		}
+	}
+}
+
+func TestSyncWebhookAction(t *testing.T) {
+	if got := syncWebhookAction("email", "https://example.com/hook"); got != "email,webhook" {
+		t.Errorf("expected webhook to be appended, got %q", got)
+	}
+	if got := syncWebhookAction("email,webhook", "https://example.com/hook"); got != "email,webhook" {
+		t.Errorf("expected webhook not to be duplicated, got %q", got)
+	}
+	// Clearing the URL removes webhook from the actions list
+	if got := syncWebhookAction("email,webhook", ""); got != "email" {
+		t.Errorf("expected webhook to be removed when the URL is cleared, got %q", got)
+	}
+	if got := syncWebhookAction("", ""); got != "" {
+		t.Errorf("expected empty actions to stay empty, got %q", got)
+	}
+}
+
+func TestValidateStrptimeFormat(t *testing.T) {
+	cases := []struct {
+		format string
+		valid  bool
+	}{
+		{"", true},
+		{"%FT%T.%Q%:z", true},
+		{"%Y-%m-%d %H:%M:%S", true},
+		{"%s", true},
+		{"100%%", true},
+		{"%Y-%m-%d %E", false},
+		{"%FT%T.%Q%", false},
+		{"%L", false},
+	}
+	for _, c := range cases {
+		_, errs := validateStrptimeFormat(c.format, "dispatch_time_format")
+		if c.valid && len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got: %v", c.format, errs)
+		}
+		if !c.valid && len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", c.format)
+		}
+	}
+}
+
+func TestSuppressAlertExpiresDefault(t *testing.T) {
+	suppress := suppressDefault(defaultAlertExpires)
+	if !suppress("alert_expires", "24h", "", nil) {
+		t.Error("expected the 24h server default to be suppressed against an unset config")
+	}
+	if suppress("alert_expires", "30d", "", nil) {
+		t.Error("expected a non-default remote value not to be suppressed")
+	}
+	if suppress("alert_expires", "24h", "30d", nil) {
+		t.Error("expected an explicit config change not to be suppressed")
+	}
+}
+
+func TestOrphanedActionParamWarnings(t *testing.T) {
+	isSet := func(configured ...string) func(string) bool {
+		set := make(map[string]bool)
+		for _, key := range configured {
+			set[key] = true
+		}
+		return func(key string) bool { return set[key] }
+	}
+
+	warnings := orphanedActionParamWarnings("email", isSet("action_slack_param_channel"))
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "action_slack_param_channel") {
+		t.Errorf("expected a warning for action_slack_param_channel, got %v", warnings)
+	}
+
+	warnings = orphanedActionParamWarnings("email", isSet("action_webhook_param_url", "action_pagerduty_integration_key"))
+	if len(warnings) != 2 {
+		t.Errorf("expected two warnings, got %v", warnings)
+	}
+
+	if warnings = orphanedActionParamWarnings("slack", isSet("action_slack_param_channel")); len(warnings) != 0 {
+		t.Errorf("expected no warnings when the action is enabled, got %v", warnings)
+	}
+	if warnings = orphanedActionParamWarnings("email", isSet()); len(warnings) != 0 {
+		t.Errorf("expected no warnings without configured params, got %v", warnings)
+	}
+}
+
+func TestValidateEmailList(t *testing.T) {
+	for _, valid := range []string{
+		"",
+		"splunk@splunk.com",
+		"a@x.com,b@x.com",
+		"a@x.com; b@x.com, c@x.com",
+		"Ops <ops@x.com>; oncall@x.com",
+	} {
+		if _, errs := validateEmailList(valid, "action_email_to"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got: %v", valid, errs)
+		}
+	}
+	for _, invalid := range []string{
+		"not-an-address",
+		"a@x.com,bad",
+		"a@x.com; Ops ops@x.com",
+	} {
+		if _, errs := validateEmailList(invalid, "action_email_to"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", invalid)
+		}
+	}
+}
+
+func TestTimeRangeRoundTrip(t *testing.T) {
+	obj := &models.SavedSearchObject{}
+	applyTimeRange(obj, []interface{}{map[string]interface{}{
+		"earliest":       "rt-15m",
+		"latest":         "rt-0m",
+		"index_earliest": "-10m",
+		"index_latest":   "-5m",
+	}})
+
+	if obj.DispatchEarliestTime != "rt-15m" || obj.DispatchLatestTime != "rt-0m" ||
+		obj.DispatchIndexEarliest != "-10m" || obj.DispatchIndexLatest != "-5m" {
+		t.Errorf("applied time range invalid: %+v", obj)
+	}
+
+	flattened := flattenTimeRange(obj)
+	if len(flattened) != 1 {
+		t.Fatalf("expected a single time_range block, got %d", len(flattened))
+	}
+	block := flattened[0].(map[string]interface{})
+	for key, want := range map[string]string{
+		"earliest":       "rt-15m",
+		"latest":         "rt-0m",
+		"index_earliest": "-10m",
+		"index_latest":   "-5m",
+	} {
+		if block[key] != want {
+			t.Errorf("flattened %s invalid, got %v, want %q", key, block[key], want)
+		}
+	}
+}
+
+func TestParseSavedSearchImportID(t *testing.T) {
+	app, owner, name, err := parseSavedSearchImportID("myapp/alice/my_search")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if app != "myapp" || owner != "alice" || name != "my_search" {
+		t.Errorf("parsed import ID invalid, got %q/%q/%q", app, owner, name)
+	}
+
+	app, owner, name, err = parseSavedSearchImportID("my_search")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if app != "" || owner != "" || name != "my_search" {
+		t.Errorf("parsed bare import ID invalid, got %q/%q/%q", app, owner, name)
+	}
+
+	for _, malformed := range []string{"", "myapp/my_search", "a/b/c/d", "myapp//my_search"} {
+		if _, _, _, err := parseSavedSearchImportID(malformed); err == nil {
+			t.Errorf("expected import ID %q to be rejected", malformed)
+		}
+	}
+}
+
+func TestDiscoverSavedSearchNamespace(t *testing.T) {
+	body := `{"entry":[{"name":"my_search","acl":{"app":"reporting","owner":"alice"}}]}`
+	var requestedPath string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		_, _ = w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	os.Setenv("HTTPScheme", "http")
+	defer os.Unsetenv("HTTPScheme")
+
+	u := strings.TrimPrefix(ts.URL, "http://")
+	c, err := client.NewSplunkdClient("", [2]string{"admin", "changeme"}, u, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := &SplunkProvider{Client: c}
+
+	// A search that only exists in a non-search app resolves to its real namespace.
+	app, owner, err := discoverSavedSearchNamespace(provider, "my_search")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if app != "reporting" || owner != "alice" {
+		t.Errorf("discovered namespace invalid, got %q/%q", app, owner)
+	}
+	if requestedPath != "/servicesNS/-/-/saved/searches/my_search" {
+		t.Errorf("expected the wildcard namespace to be probed, got %q", requestedPath)
+	}
+
+	body = `{"entry":[{"name":"my_search","acl":{"app":"reporting","owner":"alice"}},` +
+		`{"name":"my_search","acl":{"app":"security","owner":"bob"}}]}`
+	_, _, err = discoverSavedSearchNamespace(provider, "my_search")
+	if err == nil {
+		t.Fatal("expected an error for a search that exists in multiple apps")
+	}
+	if !strings.Contains(err.Error(), "reporting") || !strings.Contains(err.Error(), "security") {
+		t.Errorf("expected the ambiguity error to list the apps, got: %v", err)
+	}
+
+	body = `{"entry":[]}`
+	if _, _, err = discoverSavedSearchNamespace(provider, "my_search"); err == nil {
+		t.Error("expected an error for a search that exists in no app")
+	}
+}
+
+func TestRunOnStartupWarning(t *testing.T) {
+	if w := runOnStartupWarning(true, true, "index=main | stats count by host"); w == "" {
+		t.Error("expected a warning for a non-lookup search")
+	}
+	if w := runOnStartupWarning(true, false, "index=main | outputlookup hosts.csv"); w == "" {
+		t.Error("expected a warning for an unscheduled search")
+	}
+	if w := runOnStartupWarning(true, true, "index=main | outputlookup hosts.csv"); w != "" {
+		t.Errorf("expected no warning for a scheduled lookup-populating search, got %q", w)
+	}
+	if w := runOnStartupWarning(false, false, "index=main"); w != "" {
+		t.Errorf("expected no warning when run_on_startup is off, got %q", w)
+	}
+}
+
+func TestLookupPriorityOverride(t *testing.T) {
+	overrides := map[string]interface{}{
+		"Critical:High": "5",
+		"Medium:Low":    "4",
+	}
+	if priority, ok := lookupPriorityOverride(overrides, "Critical", "High"); !ok || priority != 5 {
+		t.Errorf("expected override 5 for Critical/High, got %d (found=%v)", priority, ok)
+	}
+	if priority, ok := lookupPriorityOverride(overrides, "Medium", "Low"); !ok || priority != 4 {
+		t.Errorf("expected override 4 for Medium/Low, got %d (found=%v)", priority, ok)
+	}
+	// Combinations missing from the map fall back to the built-in matrix
+	if _, ok := lookupPriorityOverride(overrides, "High", "High"); ok {
+		t.Error("expected no override for High/High")
+	}
+	// Malformed keys and non-numeric values are ignored
+	malformed := map[string]interface{}{
+		"CriticalHigh":   "5",
+		"Critical:":      "5",
+		":High":          "5",
+		"Critical:High":  "urgent",
+		"Critical:Low:x": "2",
+	}
+	if _, ok := lookupPriorityOverride(malformed, "Critical", "High"); ok {
+		t.Error("expected malformed entries to be ignored")
+	}
+	if _, ok := lookupPriorityOverride(malformed, "Critical", "Low"); ok {
+		t.Error("expected three-part keys to be ignored")
+	}
+}
+
+func TestSkippedCountDecoded(t *testing.T) {
+	content := []byte(`{"search": "index=main | stats count", "skipped_count": 7}`)
+	object := models.SavedSearchObject{}
+	if err := json.Unmarshal(content, &object); err != nil {
+		t.Fatal(err)
+	}
+	if object.SkippedCount != 7 {
+		t.Errorf("expected skipped_count 7, got %d", object.SkippedCount)
+	}
+}
+
+func TestTriggeredAlertCountDecoded(t *testing.T) {
+	content := []byte(`{"search": "index=main | stats count", "triggered_alert_count": 12}`)
+	object := models.SavedSearchObject{}
+	if err := json.Unmarshal(content, &object); err != nil {
+		t.Fatal(err)
+	}
+	if object.TriggeredAlertCount != 12 {
+		t.Errorf("expected triggered_alert_count 12, got %d", object.TriggeredAlertCount)
+	}
+}
+
+func TestSuppressEmptyEquals(t *testing.T) {
+	suppress := suppressEmptyEquals("none")
+	if !suppress("action_slack_param_attachment", "", "none", nil) {
+		t.Error("expected empty server value to equal the none default")
+	}
+	if !suppress("action_slack_param_attachment", "none", "", nil) {
+		t.Error("expected the none default to equal an unset config value")
+	}
+	if suppress("action_slack_param_attachment", "alert_link", "none", nil) {
+		t.Error("expected a real value change to produce a diff")
+	}
+	if suppress("action_slack_param_attachment", "", "message", nil) {
+		t.Error("expected an empty server value to differ from a non-default config")
+	}
+}
+
+func TestBaseSearchSPL(t *testing.T) {
+	spl := buildBaseSearchSPL("Base Errors", "stats count by host")
+	if spl != `| savedsearch "Base Errors" | stats count by host` {
+		t.Errorf("unexpected wrapped SPL %q", spl)
+	}
+
+	// A leading pipe on the child search must not double up
+	spl = buildBaseSearchSPL("Base Errors", "| stats count by host")
+	if spl != `| savedsearch "Base Errors" | stats count by host` {
+		t.Errorf("unexpected wrapped SPL %q", spl)
+	}
+
+	child, wrapped := stripBaseSearchSPL(spl, "Base Errors")
+	if !wrapped || child != "stats count by host" {
+		t.Errorf("unexpected unwrapped SPL %q (wrapped=%v)", child, wrapped)
+	}
+
+	if _, wrapped := stripBaseSearchSPL("index=main", "Base Errors"); wrapped {
+		t.Error("expected an unwrapped search to be reported as such")
+	}
+}
+
+func TestExplicitZeroWebhookPriority(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, savedSearches().Schema, map[string]interface{}{
+		"action_webhook_param_priority": 0,
+		"severity":                      "Critical",
+		"precision":                     "High",
+	})
+	if priority := getCalculatedPriority(d); priority != 0 {
+		t.Errorf("expected explicit priority 0 to be honored, got %d", priority)
+	}
+
+	d = schema.TestResourceDataRaw(t, savedSearches().Schema, map[string]interface{}{
+		"severity":  "Critical",
+		"precision": "High",
+	})
+	if priority := getCalculatedPriority(d); priority != 4 {
+		t.Errorf("expected unset priority to auto-calculate to 4, got %d", priority)
+	}
+}
+
+func TestEffectivelyScheduled(t *testing.T) {
+	cases := []struct {
+		isScheduled  bool
+		disabled     bool
+		cronSchedule string
+		want         bool
+	}{
+		{true, false, "*/5 * * * *", true},
+		{true, true, "*/5 * * * *", false},
+		{true, false, "", false},
+		{false, false, "*/5 * * * *", false},
+		{false, true, "", false},
+	}
+	for _, c := range cases {
+		if got := effectivelyScheduled(c.isScheduled, c.disabled, c.cronSchedule); got != c.want {
+			t.Errorf("effectivelyScheduled(%v, %v, %q) = %v, want %v", c.isScheduled, c.disabled, c.cronSchedule, got, c.want)
+		}
+	}
+}
+
+func TestColorRoundTrip(t *testing.T) {
+	content := []byte(`{"search": "index=main", "display.general.color": "#D94E17"}`)
+	object := models.SavedSearchObject{}
+	if err := json.Unmarshal(content, &object); err != nil {
+		t.Fatal(err)
+	}
+	if object.DisplayGeneralColor != "#D94E17" {
+		t.Errorf("expected color to decode from display.general.color, got %q", object.DisplayGeneralColor)
+	}
+
+	encoded, err := json.Marshal(&object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(encoded), `"display.general.color":"#D94E17"`) {
+		t.Errorf("expected color to encode under display.general.color, got %s", encoded)
+	}
+}
+
+func TestRequireComparatorThresholdPair(t *testing.T) {
+	if err := requireComparatorThresholdPair("greater than", "10"); err != nil {
+		t.Errorf("expected paired fields to validate, got %v", err)
+	}
+	if err := requireComparatorThresholdPair("", ""); err != nil {
+		t.Errorf("expected both fields unset to validate, got %v", err)
+	}
+	if err := requireComparatorThresholdPair("greater than", ""); err == nil {
+		t.Error("expected an error when alert_threshold is missing")
+	}
+	if err := requireComparatorThresholdPair("", "10"); err == nil {
+		t.Error("expected an error when alert_comparator is missing")
+	}
+}
+
+func TestMissingActionParams(t *testing.T) {
+	configured := map[string]bool{
+		"action_email_to":            true,
+		"action_slack_param_channel": true,
+	}
+	isSet := func(key string) bool { return configured[key] }
+
+	if missing := missingActionParams("email,slack", isSet); len(missing) != 0 {
+		t.Errorf("expected no missing parameters, got %v", missing)
+	}
+
+	missing := missingActionParams("email,webhook", isSet)
+	if len(missing) != 1 || !strings.Contains(missing[0], "action_webhook_param_url") {
+		t.Errorf("expected webhook URL to be reported missing, got %v", missing)
+	}
+
+	missing = missingActionParams("telegram", isSet)
+	if len(missing) != 2 {
+		t.Errorf("expected both telegram parameters to be reported missing, got %v", missing)
+	}
+
+	if missing := missingActionParams("", isSet); len(missing) != 0 {
+		t.Errorf("expected no missing parameters without actions, got %v", missing)
+	}
+
+	// Group and lookup expansion populate action_email_to at apply time, so
+	// either one satisfies the recipient requirement on its own.
+	groupsOnly := func(key string) bool { return key == "action_email_to_groups" }
+	if missing := missingActionParams("email", groupsOnly); len(missing) != 0 {
+		t.Errorf("expected action_email_to_groups to satisfy the email recipients, got %v", missing)
+	}
+	lookupOnly := func(key string) bool { return key == "action_email_to_lookup" }
+	if missing := missingActionParams("email", lookupOnly); len(missing) != 0 {
+		t.Errorf("expected action_email_to_lookup to satisfy the email recipients, got %v", missing)
+	}
+
+	missing = missingActionParams("email", func(string) bool { return false })
+	if len(missing) != 1 || !strings.Contains(missing[0], "action_email_to or action_email_to_groups or action_email_to_lookup") {
+		t.Errorf("expected the error to list the recipient alternatives, got %v", missing)
+	}
+}
+
+func TestDisallowedIndexes(t *testing.T) {
+	allowed := []string{"main", "security"}
+
+	violations, warnings := disallowedIndexes(`index=main sourcetype=syslog | stats count`, allowed)
+	if len(violations) != 0 || len(warnings) != 0 {
+		t.Errorf("expected a compliant search, got violations %v warnings %v", violations, warnings)
+	}
+
+	violations, _ = disallowedIndexes(`index=main OR index="finance" | stats count`, allowed)
+	if len(violations) != 1 || violations[0] != "finance" {
+		t.Errorf("expected finance to be flagged, got %v", violations)
+	}
+
+	violations, warnings = disallowedIndexes("index=sec* | `summarize_errors` | stats count", allowed)
+	if len(violations) != 0 {
+		t.Errorf("expected no hard violations for wildcard/macro search, got %v", violations)
+	}
+	if len(warnings) != 2 {
+		t.Errorf("expected wildcard and macro warnings, got %v", warnings)
+	}
+
+	// Matching is case-insensitive in both directions
+	violations, _ = disallowedIndexes(`INDEX=Main`, allowed)
+	if len(violations) != 0 {
+		t.Errorf("expected case-insensitive match, got %v", violations)
+	}
+}
+
+func TestCoerceBoolLikeInt(t *testing.T) {
+	cases := []struct {
+		input string
+		want  int
+		ok    bool
+	}{
+		{"0", 0, true},
+		{"1", 1, true},
+		{"true", 1, true},
+		{"false", 0, true},
+		{"True", 1, true},
+		{" FALSE ", 0, true},
+		{"", 0, true},
+		{"2", 0, false},
+		{"yes", 0, false},
+	}
+	for _, c := range cases {
+		got, ok := coerceBoolLikeInt(c.input)
+		if got != c.want || ok != c.ok {
+			t.Errorf("coerceBoolLikeInt(%q) = (%d, %v), want (%d, %v)", c.input, got, ok, c.want, c.ok)
+		}
+	}
+
+	if normalized := normalizeBoolLikeInt("true"); normalized != "1" {
+		t.Errorf("expected true to normalize to 1, got %q", normalized)
+	}
+	if normalized := normalizeBoolLikeInt(0); normalized != "0" {
+		t.Errorf("expected 0 to stay 0, got %q", normalized)
+	}
+}
+
+func TestValidateTimeSpecifier(t *testing.T) {
+	for _, valid := range []string{"", "60", "5m", "24h", "1d", "2w", "30s"} {
+		if _, errs := validateTimeSpecifier(valid, "alert_suppress_period"); len(errs) != 0 {
+			t.Errorf("expected %q to validate, got %v", valid, errs)
+		}
+	}
+	for _, invalid := range []string{"m5", "5 m", "five minutes", "-5m", "5mm"} {
+		if _, errs := validateTimeSpecifier(invalid, "alert_suppress_period"); len(errs) == 0 {
+			t.Errorf("expected %q to be rejected", invalid)
+		}
+	}
+}
+
+func TestRequireSuppressFieldsForPerResult(t *testing.T) {
+	if err := requireSuppressFieldsForPerResult(true, true, ""); err == nil {
+		t.Error("expected an error for per-result suppression without fields")
+	}
+	if err := requireSuppressFieldsForPerResult(true, true, "host"); err != nil {
+		t.Errorf("expected per-result suppression with fields to validate, got %v", err)
+	}
+	if err := requireSuppressFieldsForPerResult(true, false, ""); err != nil {
+		t.Errorf("expected digest-mode suppression without fields to validate, got %v", err)
+	}
+	if err := requireSuppressFieldsForPerResult(false, true, ""); err != nil {
+		t.Errorf("expected no error when suppression is off, got %v", err)
+	}
+}
+
+func TestNextCronTime(t *testing.T) {
+	from := time.Date(2023, time.March, 15, 10, 2, 30, 0, time.UTC) // a Wednesday
+
+	next, ok := nextCronTime("*/5 * * * *", from)
+	if !ok || !next.Equal(time.Date(2023, time.March, 15, 10, 5, 0, 0, time.UTC)) {
+		t.Errorf("unexpected next run for */5 schedule: %v (ok=%v)", next, ok)
+	}
+
+	next, ok = nextCronTime("0 0 * * 1", from)
+	if !ok || !next.Equal(time.Date(2023, time.March, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected next Monday midnight run: %v (ok=%v)", next, ok)
+	}
+
+	if _, ok := nextCronTime("not a cron", from); ok {
+		t.Error("expected an unexpandable expression to be rejected")
+	}
+}
+
+func TestForecastFromEntries(t *testing.T) {
+	from := time.Date(2023, time.March, 15, 10, 0, 0, 0, time.UTC)
+	entries := []models.SavedSearchesEntry{
+		{Name: "hourly", Content: models.SavedSearchObject{IsScheduled: true, CronSchedule: "0 * * * *"}},
+		{Name: "every_minute", Content: models.SavedSearchObject{IsScheduled: true, CronSchedule: "* * * * *"}},
+		{Name: "disabled", Content: models.SavedSearchObject{IsScheduled: true, Disabled: true, CronSchedule: "* * * * *"}},
+		{Name: "unscheduled", Content: models.SavedSearchObject{CronSchedule: "* * * * *"}},
+		{Name: "also_every_minute", Content: models.SavedSearchObject{IsScheduled: true, CronSchedule: "* * * * *"}},
+	}
+
+	schedule := forecastFromEntries(entries, from)
+	if len(schedule) != 3 {
+		t.Fatalf("expected 3 forecast entries, got %d", len(schedule))
+	}
+	names := make([]string, len(schedule))
+	for i, item := range schedule {
+		names[i] = item.(map[string]interface{})["name"].(string)
+	}
+	// Ties sort by name, later runs after
+	if names[0] != "also_every_minute" || names[1] != "every_minute" || names[2] != "hourly" {
+		t.Errorf("unexpected forecast order %v", names)
+	}
+	if next := schedule[2].(map[string]interface{})["next_run"]; next != "2023-03-15T11:00:00Z" {
+		t.Errorf("unexpected hourly next_run %v", next)
+	}
+}
+
+func TestSuppressNonRealtimeRtSpanDiff(t *testing.T) {
+	if !isRealtimeSearch("rt-15m", "rt-0m") || !isRealtimeSearch("", "rt") {
+		t.Error("expected rt-prefixed windows to count as real-time")
+	}
+	if isRealtimeSearch("-15m", "now") {
+		t.Error("expected a historical window to not count as real-time")
+	}
+
+	rt := schema.TestResourceDataRaw(t, savedSearches().Schema, map[string]interface{}{
+		"dispatch_earliest_time": "rt-15m",
+		"dispatch_latest_time":   "rt-0m",
+	})
+	if suppressNonRealtimeRtSpanDiff("dispatch_rt_maximum_span", "0", "30", rt) {
+		t.Error("expected span changes on a real-time search to diff")
+	}
+
+	historical := schema.TestResourceDataRaw(t, savedSearches().Schema, map[string]interface{}{
+		"dispatch_earliest_time": "-15m",
+		"dispatch_latest_time":   "now",
+	})
+	if !suppressNonRealtimeRtSpanDiff("dispatch_rt_maximum_span", "0", "30", historical) {
+		t.Error("expected span changes on a historical search to be suppressed")
+	}
+}
+
+func TestValidateEmbedEligibility(t *testing.T) {
+	if err := validateEmbedEligibility(true, true, false); err != nil {
+		t.Errorf("expected a scheduled enabled search to be embeddable, got %v", err)
+	}
+	if err := validateEmbedEligibility(true, false, false); err == nil {
+		t.Error("expected an error for an unscheduled search")
+	}
+	if err := validateEmbedEligibility(true, true, true); err == nil {
+		t.Error("expected an error for a disabled search")
+	}
+	if err := validateEmbedEligibility(false, false, true); err != nil {
+		t.Errorf("expected no error when embedding is off, got %v", err)
+	}
+}
+
+func TestParseSavedSearchStanza(t *testing.T) {
+	stanza := `
+# Errors by host, paged to on-call
+[Errors By Host]
+search = index=main level=error \
+  | stats count by host
+cron_schedule = */5 * * * *
+action.email.to = oncall@example.com
+alert.suppress = 1
+`
+	name, settings, err := parseSavedSearchStanza(stanza)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "Errors By Host" {
+		t.Errorf("unexpected stanza name %q", name)
+	}
+	if settings["search"] != "index=main level=error | stats count by host" {
+		t.Errorf("continuation line not joined, got %q", settings["search"])
+	}
+	if settings["cron_schedule"] != "*/5 * * * *" || settings["action.email.to"] != "oncall@example.com" || settings["alert.suppress"] != "1" {
+		t.Errorf("unexpected settings %v", settings)
+	}
+
+	if _, _, err := parseSavedSearchStanza("search = index=main"); err == nil {
+		t.Error("expected an error for a stanza without a header")
+	}
+	if _, _, err := parseSavedSearchStanza("[a]\n[b]"); err == nil {
+		t.Error("expected an error for multiple stanzas")
+	}
+	if _, _, err := parseSavedSearchStanza("[a]\nnot a setting"); err == nil {
+		t.Error("expected an error for a malformed line")
+	}
+}
+
+func TestNormalizeDelimitedString(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"", ""},
+		{"a@example.com", "a@example.com"},
+		{"a@example.com, b@example.com", "a@example.com,b@example.com"},
+		{"b@example.com;a@example.com", "a@example.com,b@example.com"},
+		{" b@example.com , a@example.com , ", "a@example.com,b@example.com"},
+	}
+	for _, c := range cases {
+		if got := normalizeDelimitedString(c.value); got != c.expected {
+			t.Errorf("normalizeDelimitedString(%q) = %q, expected %q", c.value, got, c.expected)
+		}
+	}
+
+	if !suppressDelimitedOrderDiff("", "b@x.com,a@x.com", "a@x.com; b@x.com", nil) {
+		t.Errorf("expected reordered recipient lists to be suppressed")
+	}
+	if suppressDelimitedOrderDiff("", "a@x.com", "a@x.com,b@x.com", nil) {
+		t.Errorf("expected genuinely different recipient lists not to be suppressed")
+	}
+}
+
+func TestReconcileEmbedACL(t *testing.T) {
+	configured := []interface{}{map[string]interface{}{
+		"sharing": "app",
+		"read":    []interface{}{"admin"},
+	}}
+	flattened := []interface{}{map[string]interface{}{
+		"sharing": "global",
+		"read":    []string{"*"},
+	}}
+
+	reconciled := reconcileEmbedACL(flattened, configured, true)
+	result := reconciled[0].(map[string]interface{})
+	if result["sharing"] != "app" {
+		t.Errorf("expected embed-induced global sharing to be reconciled to app, got %v", result["sharing"])
+	}
+	read, ok := result["read"].([]interface{})
+	if !ok || len(read) != 1 || read[0] != "admin" {
+		t.Errorf("expected embed-induced read perms to be reconciled to configured values, got %v", result["read"])
+	}
+
+	// With embed disabled the server ACL passes through untouched.
+	flattened = []interface{}{map[string]interface{}{
+		"sharing": "global",
+		"read":    []string{"*"},
+	}}
+	reconciled = reconcileEmbedACL(flattened, configured, false)
+	result = reconciled[0].(map[string]interface{})
+	if result["sharing"] != "global" {
+		t.Errorf("expected server sharing to pass through when embed is disabled, got %v", result["sharing"])
+	}
+
+	// A deliberate global share is not rewritten.
+	globalConfigured := []interface{}{map[string]interface{}{
+		"sharing": "global",
+		"read":    []interface{}{"*"},
+	}}
+	flattened = []interface{}{map[string]interface{}{
+		"sharing": "global",
+		"read":    []string{"*"},
+	}}
+	reconciled = reconcileEmbedACL(flattened, globalConfigured, true)
+	result = reconciled[0].(map[string]interface{})
+	if result["sharing"] != "global" {
+		t.Errorf("expected a configured global share to be kept, got %v", result["sharing"])
+	}
+}
+
+func TestPDFDeliveryRoundTrip(t *testing.T) {
+	block := map[string]interface{}{
+		"enabled":      true,
+		"view":         "my_dashboard",
+		"paper_size":   "a4",
+		"orientation":  "landscape",
+		"include_logo": true,
+	}
+
+	savedSearchesObj := &models.SavedSearchObject{}
+	expandPDFDelivery(block, savedSearchesObj)
+	if !savedSearchesObj.ActionEmailSendPDF || savedSearchesObj.ActionEmailPDFView != "my_dashboard" ||
+		savedSearchesObj.ActionEmailReportPaperSize != "a4" ||
+		savedSearchesObj.ActionEmailReportPaperOrientation != "landscape" ||
+		!savedSearchesObj.ActionEmailReportIncludeSplunkLogo {
+		t.Errorf("expandPDFDelivery did not map all fields: %+v", savedSearchesObj)
+	}
+
+	flattened := flattenPDFDelivery(savedSearchesObj)
+	for key, expected := range block {
+		if flattened[key] != expected {
+			t.Errorf("round trip lost %s: got %v, expected %v", key, flattened[key], expected)
+		}
+	}
+}
+
+func TestValidateSchedulingConsistency(t *testing.T) {
+	if err := validateSchedulingConsistency(true, true, "*/5 * * * *", "email"); err != nil {
+		t.Errorf("expected a scheduled search with a cron_schedule to validate, got %v", err)
+	}
+	if err := validateSchedulingConsistency(true, true, "", ""); err == nil {
+		t.Error("expected an error when is_scheduled is set without a cron_schedule")
+	}
+	if err := validateSchedulingConsistency(false, true, "*/5 * * * *", "email"); err == nil {
+		t.Error("expected an error when actions are enabled on an explicitly unscheduled search")
+	}
+	// An omitted is_scheduled is left to the server default rather than flagged.
+	if err := validateSchedulingConsistency(false, false, "*/5 * * * *", "email"); err != nil {
+		t.Errorf("expected an omitted is_scheduled to pass, got %v", err)
+	}
+	if err := validateSchedulingConsistency(false, true, "", ""); err != nil {
+		t.Errorf("expected an unscheduled search without actions to validate, got %v", err)
+	}
+}
+
+const savedSearchesUserSharing = `
+resource "splunk_saved_searches" "sharing" {
+    name = "Test Sharing Transition Alert"
+    search = "index=main"
+    acl {
+      owner = "admin"
+      app = "search"
+      sharing = "user"
+    }
+}
+`
+
+const savedSearchesGlobalSharing = `
+resource "splunk_saved_searches" "sharing" {
+    name = "Test Sharing Transition Alert"
+    search = "index=main"
+    acl {
+      owner = "admin"
+      app = "search"
+      sharing = "global"
+      read = ["*"]
+      write = ["admin"]
+    }
+}
+`
+
+func TestAccSplunkSavedSearchesSharingTransition(t *testing.T) {
+	resourceName := "splunk_saved_searches.sharing"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSplunkSavedSearchesDestroyResources,
+		Steps: []resource.TestStep{
+			{
+				Config: savedSearchesUserSharing,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "acl.0.sharing", "user"),
+					resource.TestCheckResourceAttr(resourceName, "acl.0.app", "search"),
+				),
+			},
+			{
+				// Moving from user to global sharing updates the ACL in place
+				// instead of recreating the saved search.
+				Config: savedSearchesGlobalSharing,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "acl.0.sharing", "global"),
+					resource.TestCheckResourceAttr(resourceName, "acl.0.app", "search"),
+					resource.TestCheckResourceAttr(resourceName, "acl.0.read.0", "*"),
+				),
+			},
+		},
+	})
+}
+
+func TestActionsRawPreservesServerOrdering(t *testing.T) {
+	payload := `{"actions": "webhook, email ,slack"}`
+	content := models.SavedSearchObject{}
+	if err := json.Unmarshal([]byte(payload), &content); err != nil {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+
+	// actions_raw is set straight from the decoded content, so the server's
+	// ordering and spacing survive while actions is normalized.
+	if content.Actions != "webhook, email ,slack" {
+		t.Errorf("expected server ordering to be preserved, got %q", content.Actions)
+	}
+	if normalized := normalizeActionsString(content.Actions); normalized == content.Actions {
+		t.Errorf("expected normalization to diverge from the raw value, got %q for both", normalized)
+	}
+}
+
+func TestActionKeyindicatorRoundTrip(t *testing.T) {
+	content := models.SavedSearchObject{
+		ActionKeyindicator:                  true,
+		ActionKeyindicatorParamTitle:        "Malware Infections",
+		ActionKeyindicatorParamSubtitle:     "last 24h",
+		ActionKeyindicatorParamValueSuffix:  "hosts",
+		ActionKeyindicatorParamInvert:       "1",
+		ActionKeyindicatorParamThreshold:    "100",
+		ActionKeyindicatorParamDrilldownURI: "malware_center",
+	}
+
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+
+	decoded := models.SavedSearchObject{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if decoded != content {
+		t.Errorf("keyindicator fields did not round trip: got %+v", decoded)
+	}
+
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &raw); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	for _, key := range []string{
+		"action.keyindicator",
+		"action.keyindicator.param.title",
+		"action.keyindicator.param.drilldown_uri",
+	} {
+		if _, ok := raw[key]; !ok {
+			t.Errorf("expected conf-style key %q in the encoded object", key)
+		}
+	}
+}
+
+func TestActionEmailAuthMechanismRoundTrip(t *testing.T) {
+	content := models.SavedSearchObject{
+		ActionEmailAuthMechanism: "cram-md5",
+		ActionEmailAuthUsername:  "alerts",
+	}
+
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+
+	decoded := models.SavedSearchObject{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if decoded != content {
+		t.Errorf("email auth fields did not round trip: got %+v", decoded)
+	}
+
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &raw); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if _, ok := raw["action.email.auth_mechanism"]; !ok {
+		t.Error("expected conf-style key action.email.auth_mechanism in the encoded object")
+	}
+}
+
+func TestActionNotableParamsRoundTrip(t *testing.T) {
+	content := models.SavedSearchObject{
+		ActionNotable:                     true,
+		ActionNotableParamRuleTitle:       "Brute force against $dest$",
+		ActionNotableParamRuleDescription: "Excessive failed logins detected",
+		ActionNotableParamSecurityDomain:  "access",
+		ActionNotableParamSeverity:        "high",
+		ActionNotableParamNesFields:       "src,dest",
+	}
+
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+
+	decoded := models.SavedSearchObject{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if decoded != content {
+		t.Errorf("notable fields did not round trip: got %+v", decoded)
+	}
+
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &raw); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	for _, key := range []string{"action.notable", "action.notable.param.security_domain", "action.notable.param.nes_fields"} {
+		if _, ok := raw[key]; !ok {
+			t.Errorf("expected conf-style key %s in the encoded object", key)
+		}
+	}
+}
+
+func TestCollisionsFromEntries(t *testing.T) {
+	entry := func(name, cron string) models.SavedSearchesEntry {
+		e := models.SavedSearchesEntry{Name: name}
+		e.Content.IsScheduled = true
+		e.Content.CronSchedule = cron
+		return e
+	}
+	entries := []models.SavedSearchesEntry{
+		entry("hourly_a", "0 * * * *"),
+		entry("hourly_b", "0 * * * *"),
+		entry("hourly_c", "0 * * * *"),
+		entry("daily", "0 6 * * *"),
+	}
+	disabled := entry("hourly_disabled", "0 * * * *")
+	disabled.Content.Disabled = true
+	entries = append(entries, disabled)
+
+	collisions := collisionsFromEntries(entries, nil, 2)
+	if len(collisions) != 1 {
+		t.Fatalf("expected one collision, got %v", collisions)
+	}
+	collision := collisions[0].(map[string]interface{})
+	if collision["cron_schedule"] != "0 * * * *" {
+		t.Errorf("expected the shared hourly cron, got %v", collision["cron_schedule"])
+	}
+	sharers := collision["names"].([]string)
+	if len(sharers) != 3 || sharers[0] != "hourly_a" {
+		t.Errorf("expected the three enabled hourly searches, got %v", sharers)
+	}
+
+	// Restricting to named searches drops the group below the threshold.
+	if collisions := collisionsFromEntries(entries, []string{"hourly_a", "daily"}, 2); len(collisions) != 0 {
+		t.Errorf("expected no collisions among the named searches, got %v", collisions)
+	}
+
+	if collisions := collisionsFromEntries(entries, nil, 4); len(collisions) != 0 {
+		t.Errorf("expected no collisions at threshold 4, got %v", collisions)
+	}
+}
+
+func TestExpandEmailGroups(t *testing.T) {
+	groupMap := map[string]string{
+		"oncall":   "alice@example.com, bob@example.com",
+		"security": "bob@example.com;carol@example.com",
+	}
+
+	expanded, err := expandEmailGroups([]string{"oncall", "security"}, groupMap)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Members shared between groups are deduplicated and the result is sorted.
+	if expanded != "alice@example.com,bob@example.com,carol@example.com" {
+		t.Errorf("unexpected expansion: %q", expanded)
+	}
+
+	// Round trip: the expansion compares equal to the server's canonicalized
+	// ordering through the same normalization the diff suppression uses.
+	server := "bob@example.com, carol@example.com, alice@example.com"
+	if normalizeDelimitedString(expanded) != normalizeDelimitedString(server) {
+		t.Errorf("expected expansion %q to match server list %q after normalization", expanded, server)
+	}
+
+	if _, err := expandEmailGroups([]string{"oncall", "missing"}, groupMap); err == nil {
+		t.Error("expected an error for a group missing from the provider map")
+	}
+}
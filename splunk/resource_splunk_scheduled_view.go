@@ -0,0 +1,233 @@
+package splunk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/splunk/terraform-provider-splunk/client/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func splunkScheduledView() *schema.Resource {
+	return &schema.Resource{
+		CustomizeDiff: aclValidator,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the dashboard view to deliver on a schedule. The view must already exist in the configured app.",
+			},
+			"cron_schedule": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCronSchedule,
+				Description: "The cron schedule to deliver this view. " +
+					"For example: 0 6 * * 1 delivers the view every Monday at 6am.",
+			},
+			"is_scheduled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the scheduled delivery is active. Defaults to true.",
+			},
+			"action_email": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to email the rendered view. Defaults to true; scheduled views exist to deliver PDFs.",
+			},
+			"action_email_to": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateEmailList,
+				DiffSuppressFunc: suppressDelimitedOrderDiff,
+				Description:      "Comma or semicolon separated list of recipient email addresses.",
+			},
+			"action_email_subject": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Subject of the delivery email. Defaults to Splunk Report: $name$.",
+			},
+			"acl": aclSchema(),
+		},
+		Read:   splunkScheduledViewRead,
+		Create: splunkScheduledViewCreate,
+		Delete: splunkScheduledViewDelete,
+		Update: splunkScheduledViewUpdate,
+		Importer: &schema.ResourceImporter{
+			State: splunkScheduledViewImport,
+		},
+	}
+}
+
+// splunkScheduledViewImport accepts either a bare view name or an
+// app/owner/name triple, seeding the acl block from the latter.
+func splunkScheduledViewImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	app, owner, name, err := parseSavedSearchImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(name)
+	if app != "" {
+		err := d.Set("acl", []interface{}{map[string]interface{}{
+			"app":   app,
+			"owner": owner,
+		}})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// Functions
+func splunkScheduledViewCreate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	scheduledViewObj := getSplunkScheduledViewConfig(d)
+	aclObject := getResourceDataViewACL(d)
+	name := d.Get("name").(string)
+
+	// Splunk maintains a scheduled view entity per dashboard, so creating the
+	// resource means configuring the existing entity rather than a POST to
+	// the collection.
+	err := (*provider.Client).UpdateScheduledView(name, aclObject.Owner, aclObject.App, scheduledViewObj)
+	if err != nil {
+		return err
+	}
+	if err := (*provider.Client).UpdateAcl(aclObject.Owner, aclObject.App, name, aclObject, "scheduled", "views"); err != nil {
+		return err
+	}
+	d.SetId(name)
+	return splunkScheduledViewRead(d, meta)
+}
+
+func splunkScheduledViewRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	aclObject := getResourceDataViewACL(d)
+	name := d.Id()
+	readUser := aclObject.Owner
+
+	resp, err := (*provider.Client).ReadScheduledView(name, readUser, aclObject.App)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	entry, err := getScheduledViewByName(name, resp)
+	if err != nil {
+		return err
+	}
+
+	if entry == nil {
+		return fmt.Errorf("unable to find resource: %v", name)
+	}
+
+	if err = d.Set("name", entry.Name); err != nil {
+		return err
+	}
+
+	if err = d.Set("cron_schedule", entry.Content.CronSchedule); err != nil {
+		return err
+	}
+
+	if err = d.Set("is_scheduled", entry.Content.IsScheduled); err != nil {
+		return err
+	}
+
+	if err = d.Set("action_email", entry.Content.ActionEmail); err != nil {
+		return err
+	}
+
+	if err = d.Set("action_email_to", entry.Content.ActionEmailTo); err != nil {
+		return err
+	}
+
+	if err = d.Set("action_email_subject", entry.Content.ActionEmailSubject); err != nil {
+		return err
+	}
+
+	err = d.Set("acl", flattenACL(&entry.ACL))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func splunkScheduledViewUpdate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	name := d.Get("name").(string)
+	scheduledViewObj := getSplunkScheduledViewConfig(d)
+	aclObject := getResourceDataViewACL(d)
+
+	updateUser := "nobody"
+
+	if aclObject.Sharing == "user" {
+		updateUser = aclObject.Owner
+	}
+
+	if err := (*provider.Client).UpdateScheduledView(name, updateUser, aclObject.App, scheduledViewObj); err != nil {
+		return err
+	}
+
+	if err := (*provider.Client).UpdateAcl(updateUser, aclObject.App, name, aclObject, "scheduled", "views"); err != nil {
+		return err
+	}
+
+	return splunkScheduledViewRead(d, meta)
+}
+
+func splunkScheduledViewDelete(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	name := d.Id()
+	aclObject := getResourceDataViewACL(d)
+	if aclObject.Sharing != "user" {
+		aclObject.Owner = "nobody"
+	}
+
+	// The scheduled view entity is backed by the dashboard and cannot be
+	// deleted on its own; clearing the schedule and delivery is the closest
+	// equivalent.
+	return (*provider.Client).UpdateScheduledView(name, aclObject.Owner, aclObject.App, &models.ScheduledViewObject{})
+}
+
+func getScheduledViewByName(name string, httpResponse *http.Response) (scheduledViewEntry *models.ScheduledViewEntry, err error) {
+	response := &models.ScheduledViewResponse{}
+	switch httpResponse.StatusCode {
+	case 200, 201:
+
+		decoder := json.NewDecoder(httpResponse.Body)
+		err := decoder.Decode(response)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range response.Entry {
+			if entry.Name == name {
+				return &entry, nil
+			}
+		}
+
+	default:
+		_ = json.NewDecoder(httpResponse.Body).Decode(response)
+		err := errors.New(response.Messages[0].Text)
+		return scheduledViewEntry, err
+	}
+
+	return scheduledViewEntry, nil
+}
+
+func getSplunkScheduledViewConfig(d *schema.ResourceData) (scheduledViewObject *models.ScheduledViewObject) {
+	scheduledViewObject = &models.ScheduledViewObject{
+		ActionEmail:        d.Get("action_email").(bool),
+		ActionEmailSubject: d.Get("action_email_subject").(string),
+		ActionEmailTo:      d.Get("action_email_to").(string),
+		CronSchedule:       d.Get("cron_schedule").(string),
+		IsScheduled:        d.Get("is_scheduled").(bool),
+	}
+	return scheduledViewObject
+}
@@ -0,0 +1,78 @@
+package splunk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const splunkScheduledViewObject = `
+resource "splunk_scheduled_view" "pdf" {
+  name                 = "search"
+  cron_schedule        = "0 6 * * 1"
+  action_email_to      = "ops@example.com"
+  action_email_subject = "Weekly dashboard"
+  acl {
+    owner = "admin"
+    app   = "search"
+  }
+}
+`
+
+const updateSplunkScheduledViewObject = `
+resource "splunk_scheduled_view" "pdf" {
+  name                 = "search"
+  cron_schedule        = "0 7 * * 1"
+  action_email_to      = "ops@example.com;oncall@example.com"
+  action_email_subject = "Weekly dashboard"
+  acl {
+    owner = "admin"
+    app   = "search"
+  }
+}
+`
+
+func TestAccSplunkScheduledView(t *testing.T) {
+	resourceName := "splunk_scheduled_view.pdf"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSplunkScheduledViewDestroyResources,
+		Steps: []resource.TestStep{
+			{
+				Config: splunkScheduledViewObject,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "search"),
+					resource.TestCheckResourceAttr(resourceName, "cron_schedule", "0 6 * * 1"),
+					resource.TestCheckResourceAttr(resourceName, "is_scheduled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "action_email", "true"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_to", "ops@example.com"),
+				),
+			},
+			{
+				Config: updateSplunkScheduledViewObject,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cron_schedule", "0 7 * * 1"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_to", "ops@example.com;oncall@example.com"),
+				),
+			},
+			{
+				ResourceName:      "splunk_scheduled_view.pdf",
+				ImportState:       true,
+				ImportStateId:     "search/admin/search",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Scheduled view entities are backed by their dashboards and survive resource
+// deletion with the schedule cleared, so there is nothing to check for
+// destruction beyond the provider not erroring.
+func testAccSplunkScheduledViewDestroyResources(s *terraform.State) error {
+	return nil
+}
@@ -0,0 +1,213 @@
+package splunk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/splunk/terraform-provider-splunk/client/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func splunkTags() *schema.Resource {
+	return &schema.Resource{
+		CustomizeDiff: aclValidator,
+		Schema: map[string]*schema.Schema{
+			"field": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The field the tagged value belongs to, for example host or src.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The field value the tags are assigned to.",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of tag names assigned to the field::value pair. Order is not significant.",
+			},
+			"acl": aclSchema(),
+		},
+		Read:   splunkTagsRead,
+		Create: splunkTagsCreate,
+		Delete: splunkTagsDelete,
+		Update: splunkTagsUpdate,
+		Importer: &schema.ResourceImporter{
+			State: splunkTagsImport,
+		},
+	}
+}
+
+// splunkTagsImport accepts either field/value or app/owner/field/value,
+// seeding the acl block from the latter.
+func splunkTagsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	switch len(parts) {
+	case 2:
+		d.SetId(parts[0] + "/" + parts[1])
+	case 4:
+		d.SetId(parts[2] + "/" + parts[3])
+		err := d.Set("acl", []interface{}{map[string]interface{}{
+			"app":   parts[0],
+			"owner": parts[1],
+		}})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid import id %q, expected field/value or app/owner/field/value", d.Id())
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// splitTagsID splits the resource id back into field and value.
+func splitTagsID(id string) (string, string, error) {
+	field, value, found := strings.Cut(id, "/")
+	if !found || field == "" || value == "" {
+		return "", "", fmt.Errorf("invalid tags id %q, expected field/value", id)
+	}
+	return field, value, nil
+}
+
+// Functions
+func splunkTagsCreate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	tagObject := getSplunkTagsConfig(d)
+	aclObject := getResourceDataViewACL(d)
+
+	err := (*provider.Client).CreateTags(aclObject.Owner, aclObject.App, tagObject)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(tagObject.Field + "/" + tagObject.Value)
+	return splunkTagsRead(d, meta)
+}
+
+func splunkTagsRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	aclObject := getResourceDataViewACL(d)
+	field, value, err := splitTagsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := (*provider.Client).ReadTags(aclObject.Owner, aclObject.App, field, value)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	response := &models.TagsResponse{}
+	switch resp.StatusCode {
+	case 200, 201:
+		if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+			return err
+		}
+	default:
+		_ = json.NewDecoder(resp.Body).Decode(response)
+		if len(response.Messages) > 0 {
+			return errors.New(response.Messages[0].Text)
+		}
+		return fmt.Errorf("unable to find resource: %v", d.Id())
+	}
+
+	if len(response.Entry) == 0 {
+		return fmt.Errorf("unable to find resource: %v", d.Id())
+	}
+	entry := response.Entry[0]
+
+	if err = d.Set("field", field); err != nil {
+		return err
+	}
+	if err = d.Set("value", value); err != nil {
+		return err
+	}
+	if err = d.Set("tags", tagsFromConfContent(entry.Content)); err != nil {
+		return err
+	}
+
+	err = d.Set("acl", flattenACL(&entry.ACL))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func splunkTagsUpdate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	aclObject := getResourceDataViewACL(d)
+	field, value, err := splitTagsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	oldTags, newTags := d.GetChange("tags")
+	add := setToStrings(newTags.(*schema.Set).Difference(oldTags.(*schema.Set)))
+	remove := setToStrings(oldTags.(*schema.Set).Difference(newTags.(*schema.Set)))
+
+	if len(add) > 0 || len(remove) > 0 {
+		if err := (*provider.Client).UpdateTags(aclObject.Owner, aclObject.App, field, value, add, remove); err != nil {
+			return err
+		}
+	}
+
+	return splunkTagsRead(d, meta)
+}
+
+func splunkTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	aclObject := getResourceDataViewACL(d)
+	field, value, err := splitTagsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Deleting every assigned tag removes the backing conf-tags stanza.
+	remove := setToStrings(d.Get("tags").(*schema.Set))
+	return (*provider.Client).UpdateTags(aclObject.Owner, aclObject.App, field, value, nil, remove)
+}
+
+// Helpers
+func getSplunkTagsConfig(d *schema.ResourceData) *models.TagObject {
+	return &models.TagObject{
+		Field: d.Get("field").(string),
+		Value: d.Get("value").(string),
+		Tags:  setToStrings(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func setToStrings(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		values = append(values, v.(string))
+	}
+	sort.Strings(values)
+	return values
+}
+
+// tagsFromConfContent extracts the assigned tag names from a conf-tags stanza:
+// each tag appears as a key set to enabled, alongside eai: metadata and the
+// stanza's own attributes which are skipped.
+func tagsFromConfContent(content map[string]interface{}) []string {
+	tags := []string{}
+	for key, v := range content {
+		if strings.HasPrefix(key, "eai:") || key == "disabled" {
+			continue
+		}
+		if enabled, ok := v.(string); ok && enabled == "enabled" {
+			tags = append(tags, key)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
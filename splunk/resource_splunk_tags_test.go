@@ -0,0 +1,108 @@
+package splunk
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const splunkTagsObject = `
+resource "splunk_tags" "example" {
+  field = "host"
+  value = "web-01"
+  tags  = ["production", "web"]
+}
+`
+
+const updateSplunkTagsObject = `
+resource "splunk_tags" "example" {
+  field = "host"
+  value = "web-01"
+  tags  = ["web", "dmz"]
+}
+`
+
+func TestAccSplunkTags(t *testing.T) {
+	resourceName := "splunk_tags.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSplunkTagsDestroyResources,
+		Steps: []resource.TestStep{
+			{
+				Config: splunkTagsObject,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "field", "host"),
+					resource.TestCheckResourceAttr(resourceName, "value", "web-01"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+				),
+			},
+			{
+				Config: updateSplunkTagsObject,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "splunk_tags.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "search/admin/host/web-01",
+			},
+		},
+	})
+}
+
+func testAccSplunkTagsDestroyResources(s *terraform.State) error {
+	client, err := newTestClient()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "splunk_tags":
+			endpoint := client.BuildSplunkURL(nil, "servicesNS", "admin", "search", "configs", "conf-tags", "host=web-01")
+			resp, err := client.Get(endpoint)
+			if resp.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("error: %s: %s", rs.Primary.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func TestTagsFromConfContent(t *testing.T) {
+	content := map[string]interface{}{
+		"web":          "enabled",
+		"production":   "enabled",
+		"retired":      "disabled",
+		"disabled":     false,
+		"eai:appName":  "search",
+		"eai:userName": "nobody",
+	}
+	expected := []string{"production", "web"}
+	if tags := tagsFromConfContent(content); !reflect.DeepEqual(tags, expected) {
+		t.Errorf("expected tags %v, got %v", expected, tags)
+	}
+
+	if tags := tagsFromConfContent(map[string]interface{}{}); len(tags) != 0 {
+		t.Errorf("expected no tags from an empty stanza, got %v", tags)
+	}
+}
+
+func TestSplitTagsID(t *testing.T) {
+	field, value, err := splitTagsID("host/web-01")
+	if err != nil || field != "host" || value != "web-01" {
+		t.Errorf("expected host/web-01 to split cleanly, got %q/%q (%v)", field, value, err)
+	}
+	if _, _, err := splitTagsID("host"); err == nil {
+		t.Error("expected an error for an id without a value")
+	}
+}
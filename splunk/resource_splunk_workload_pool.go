@@ -0,0 +1,181 @@
+package splunk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/splunk/terraform-provider-splunk/client/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func workloadPool() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Required. The name of the workload pool to create.",
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "search",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"search", "ingest", "misc"}, false),
+				Description:  "Valid values: (search | ingest | misc). The category the pool belongs to. Defaults to search.",
+			},
+			"cpu_weight": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Relative CPU weight of the pool within its category. Defaults to 1.",
+			},
+			"mem_weight": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Relative memory weight of the pool within its category. Defaults to 1.",
+			},
+			"default_category_pool": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether this pool is the default for its category. Defaults to false.",
+			},
+		},
+		Read:   workloadPoolRead,
+		Create: workloadPoolCreate,
+		Delete: workloadPoolDelete,
+		Update: workloadPoolUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+// Functions
+func workloadPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	name := d.Get("name").(string)
+	workloadPoolObj := getWorkloadPoolConfig(d)
+	err := (*provider.Client).CreateWorkloadPool(workloadPoolObj)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return workloadPoolRead(d, meta)
+}
+
+func workloadPoolRead(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	name := d.Id()
+	resp, err := (*provider.Client).ReadWorkloadPool(name)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	entry, err := getWorkloadPoolByName(name, resp)
+	if err != nil {
+		return err
+	}
+
+	if entry == nil {
+		return fmt.Errorf("Unable to find resource: %v", name)
+	}
+
+	if err = d.Set("name", entry.Name); err != nil {
+		return err
+	}
+
+	if err = d.Set("category", entry.Content.Category); err != nil {
+		return err
+	}
+
+	if err = d.Set("cpu_weight", entry.Content.CPUWeight); err != nil {
+		return err
+	}
+
+	if err = d.Set("mem_weight", entry.Content.MemWeight); err != nil {
+		return err
+	}
+
+	if err = d.Set("default_category_pool", entry.Content.DefaultCategoryPool); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func workloadPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	workloadPoolObj := getWorkloadPoolConfig(d)
+	err := (*provider.Client).UpdateWorkloadPool(d.Id(), workloadPoolObj)
+	if err != nil {
+		return err
+	}
+
+	return workloadPoolRead(d, meta)
+}
+
+func workloadPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	provider := meta.(*SplunkProvider)
+	resp, err := (*provider.Client).DeleteWorkloadPool(d.Id())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 200, 201:
+		return nil
+
+	default:
+		errorResponse := &models.WorkloadPoolResponse{}
+		_ = json.NewDecoder(resp.Body).Decode(errorResponse)
+		err := errors.New(errorResponse.Messages[0].Text)
+		return err
+	}
+}
+
+// Helpers
+func getWorkloadPoolConfig(d *schema.ResourceData) (workloadPoolObject *models.WorkloadPoolObject) {
+	workloadPoolObject = &models.WorkloadPoolObject{}
+	workloadPoolObject.Name = d.Get("name").(string)
+	workloadPoolObject.Category = d.Get("category").(string)
+	workloadPoolObject.CPUWeight = d.Get("cpu_weight").(int)
+	workloadPoolObject.MemWeight = d.Get("mem_weight").(int)
+	workloadPoolObject.DefaultCategoryPool = d.Get("default_category_pool").(bool)
+	return workloadPoolObject
+}
+
+func getWorkloadPoolByName(name string, httpResponse *http.Response) (workloadPoolEntry *models.WorkloadPoolEntry, err error) {
+	response := &models.WorkloadPoolResponse{}
+	switch httpResponse.StatusCode {
+	case 200, 201:
+
+		decoder := json.NewDecoder(httpResponse.Body)
+		err := decoder.Decode(response)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range response.Entry {
+			if entry.Name == name {
+				return &entry, nil
+			}
+		}
+
+	default:
+		_ = json.NewDecoder(httpResponse.Body).Decode(response)
+		err := errors.New(response.Messages[0].Text)
+		return workloadPoolEntry, err
+	}
+
+	return workloadPoolEntry, nil
+}
@@ -0,0 +1,78 @@
+package splunk
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const workloadPoolConfig = `
+resource "splunk_workload_pool" "pool" {
+  name       = "terraform_pool"
+  cpu_weight = 2
+  mem_weight = 2
+}
+`
+
+const updateWorkloadPoolConfig = `
+resource "splunk_workload_pool" "pool" {
+  name       = "terraform_pool"
+  cpu_weight = 4
+  mem_weight = 2
+}
+`
+
+// Requires workload management to be enabled on the test instance.
+func TestAccSplunkWorkloadPool(t *testing.T) {
+	resourceName := "splunk_workload_pool.pool"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSplunkWorkloadPoolDestroyResources,
+		Steps: []resource.TestStep{
+			{
+				Config: workloadPoolConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "terraform_pool"),
+					resource.TestCheckResourceAttr(resourceName, "category", "search"),
+					resource.TestCheckResourceAttr(resourceName, "cpu_weight", "2"),
+				),
+			},
+			{
+				Config: updateWorkloadPoolConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cpu_weight", "4"),
+				),
+			},
+			{
+				ResourceName:      "splunk_workload_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSplunkWorkloadPoolDestroyResources(s *terraform.State) error {
+	client, err := newTestClient()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "splunk_workload_pool":
+			endpoint := client.BuildSplunkURL(nil, "services", "admin", "workload-pools", rs.Primary.ID)
+			resp, err := client.Get(endpoint)
+			if resp.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("error: %s: %s", rs.Primary.ID, err)
+			}
+		}
+	}
+	return nil
+}